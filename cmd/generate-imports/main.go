@@ -0,0 +1,378 @@
+// generate-imports lists the configuration in an incident.io account and
+// prints Terraform `import {}` blocks for everything this provider can manage.
+//
+// Combined with `terraform plan -generate-config-out=generated.tf`, this makes
+// adopting the provider against an existing account a single command:
+//
+//	INCIDENT_API_KEY=... go run ./cmd/generate-imports > imports.tf
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	endpoint = flag.String("endpoint", "", "incident.io API endpoint (defaults to $INCIDENT_ENDPOINT or https://api.incident.io)")
+	output   = flag.String("output", "", "file to write import blocks to (defaults to stdout)")
+)
+
+// importBlock is a single Terraform import block.
+type importBlock struct {
+	Name string
+	ID   string
+}
+
+// lister returns import blocks for all objects of one resource type.
+type lister func(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error)
+
+func main() {
+	flag.Parse()
+
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "generate-imports: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	apiKey := os.Getenv("INCIDENT_API_KEY")
+	if apiKey == "" {
+		return errors.New("INCIDENT_API_KEY must be set")
+	}
+
+	apiClient, err := buildClient(apiKey)
+	if err != nil {
+		return err
+	}
+
+	listers := []struct {
+		ResourceType string
+		List         lister
+	}{
+		{"incident_catalog_type", listCatalogTypes},
+		{"incident_catalog_entries", listCatalogEntries},
+		{"incident_custom_field", listCustomFields},
+		{"incident_custom_field_option", listCustomFieldOptions},
+		{"incident_incident_role", listIncidentRoles},
+		{"incident_severity", listSeverities},
+		{"incident_status", listStatuses},
+		{"incident_schedule", listSchedules},
+		{"incident_workflow", listWorkflows},
+	}
+
+	var out io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return errors.Wrap(err, "creating output file")
+		}
+		defer file.Close()
+		out = file
+	}
+
+	fmt.Fprintln(out, "# Generated by cmd/generate-imports. Run the following to generate")
+	fmt.Fprintln(out, "# configuration for these resources:")
+	fmt.Fprintln(out, "#")
+	fmt.Fprintln(out, "#   terraform plan -generate-config-out=generated.tf")
+	fmt.Fprintln(out, "#")
+	fmt.Fprintln(out, "# incident_escalation_path is not included, as the API does not support")
+	fmt.Fprintln(out, "# listing escalation paths: import those individually by ID.")
+
+	for _, l := range listers {
+		blocks, err := l.List(ctx, apiClient)
+		if err != nil {
+			return errors.Wrapf(err, "listing %s", l.ResourceType)
+		}
+
+		names := newNamer()
+		for _, block := range blocks {
+			fmt.Fprintf(out, "\nimport {\n  to = %s.%s\n  id = %q\n}\n",
+				l.ResourceType, names.Name(block.Name), block.ID)
+		}
+	}
+
+	return nil
+}
+
+func buildClient(apiKey string) (*client.ClientWithResponses, error) {
+	url := "https://api.incident.io"
+	if override := os.Getenv("INCIDENT_ENDPOINT"); override != "" {
+		url = override
+	}
+	if *endpoint != "" {
+		url = *endpoint
+	}
+
+	bearerTokenProvider, err := securityprovider.NewSecurityProviderBearerToken(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewClientWithResponses(
+		url,
+		client.WithHTTPClient(cleanhttp.DefaultClient()),
+		client.WithRequestEditorFn(bearerTokenProvider.Intercept),
+		client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Add("user-agent", "terraform-provider-incident/generate-imports")
+			return nil
+		}),
+	)
+}
+
+// listCustomCatalogTypes returns catalog types that were created by users,
+// rather than being synced from an integration.
+func listCustomCatalogTypes(ctx context.Context, apiClient *client.ClientWithResponses) ([]client.CatalogTypeV2, error) {
+	result, err := apiClient.CatalogV2ListTypesWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(result.JSON200.CatalogTypes, func(catalogType client.CatalogTypeV2, _ int) bool {
+		return catalogType.IsEditable && strings.HasPrefix(catalogType.TypeName, "Custom[")
+	}), nil
+}
+
+func listCatalogTypes(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	catalogTypes, err := listCustomCatalogTypes(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(catalogTypes, func(catalogType client.CatalogTypeV2, _ int) importBlock {
+		return importBlock{Name: catalogType.Name, ID: catalogType.Id}
+	}), nil
+}
+
+// listCatalogEntries emits one incident_catalog_entries block per catalog
+// type, which is imported using the ID of the catalog type.
+func listCatalogEntries(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	catalogTypes, err := listCustomCatalogTypes(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(catalogTypes, func(catalogType client.CatalogTypeV2, _ int) importBlock {
+		return importBlock{Name: catalogType.Name, ID: catalogType.Id}
+	}), nil
+}
+
+func listCustomFields(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	result, err := apiClient.CustomFieldsV2ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(result.JSON200.CustomFields, func(field client.CustomFieldV2, _ int) importBlock {
+		return importBlock{Name: field.Name, ID: field.Id}
+	}), nil
+}
+
+func listCustomFieldOptions(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	fields, err := apiClient.CustomFieldsV2ListWithResponse(ctx)
+	if err == nil && fields.StatusCode() >= 400 {
+		err = clientError(fields.StatusCode(), fields.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := []importBlock{}
+	for _, field := range fields.JSON200.CustomFields {
+		// Only select fields have options that can be managed separately.
+		if field.FieldType != client.SingleSelect && field.FieldType != client.MultiSelect {
+			continue
+		}
+
+		var after *string
+		for {
+			result, err := apiClient.CustomFieldOptionsV1ListWithResponse(ctx, &client.CustomFieldOptionsV1ListParams{
+				CustomFieldId: field.Id,
+				PageSize:      lo.ToPtr(int64(250)),
+				After:         after,
+			})
+			if err == nil && result.StatusCode() >= 400 {
+				err = clientError(result.StatusCode(), result.Body)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for _, option := range result.JSON200.CustomFieldOptions {
+				blocks = append(blocks, importBlock{
+					Name: fmt.Sprintf("%s_%s", field.Name, option.Value),
+					ID:   option.Id,
+				})
+			}
+
+			if len(result.JSON200.CustomFieldOptions) == 0 || result.JSON200.PaginationMeta.After == nil {
+				break
+			}
+			after = result.JSON200.PaginationMeta.After
+		}
+	}
+
+	return blocks, nil
+}
+
+func listIncidentRoles(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	result, err := apiClient.IncidentRolesV2ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The lead and reporter roles are built-in, and can't be managed.
+	roles := lo.Filter(result.JSON200.IncidentRoles, func(role client.IncidentRoleV2, _ int) bool {
+		return role.RoleType == client.IncidentRoleV2RoleTypeCustom
+	})
+
+	return lo.Map(roles, func(role client.IncidentRoleV2, _ int) importBlock {
+		return importBlock{Name: role.Name, ID: role.Id}
+	}), nil
+}
+
+func listSeverities(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	result, err := apiClient.SeveritiesV1ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(result.JSON200.Severities, func(severity client.SeverityV2, _ int) importBlock {
+		return importBlock{Name: severity.Name, ID: severity.Id}
+	}), nil
+}
+
+func listStatuses(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	result, err := apiClient.IncidentStatusesV1ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Only live and learning statuses can be configured: the rest are managed by
+	// incident.io.
+	statuses := lo.Filter(result.JSON200.IncidentStatuses, func(status client.IncidentStatusV1, _ int) bool {
+		return status.Category == client.IncidentStatusV1CategoryLive || status.Category == client.IncidentStatusV1CategoryLearning
+	})
+
+	return lo.Map(statuses, func(status client.IncidentStatusV1, _ int) importBlock {
+		return importBlock{Name: status.Name, ID: status.Id}
+	}), nil
+}
+
+func listSchedules(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	blocks := []importBlock{}
+
+	var after *string
+	for {
+		result, err := apiClient.SchedulesV2ListWithResponse(ctx, &client.SchedulesV2ListParams{
+			PageSize: lo.ToPtr(int64(250)),
+			After:    after,
+		})
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, schedule := range result.JSON200.Schedules {
+			// Schedules synced from external providers have no config, and can't
+			// be managed by Terraform.
+			if schedule.Config == nil {
+				continue
+			}
+			blocks = append(blocks, importBlock{Name: schedule.Name, ID: schedule.Id})
+		}
+
+		if len(result.JSON200.Schedules) == 0 || result.JSON200.PaginationMeta == nil || result.JSON200.PaginationMeta.After == nil {
+			break
+		}
+		after = result.JSON200.PaginationMeta.After
+	}
+
+	return blocks, nil
+}
+
+func listWorkflows(ctx context.Context, apiClient *client.ClientWithResponses) ([]importBlock, error) {
+	result, err := apiClient.WorkflowsV2ListWorkflowsWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(result.JSON200.Workflows, func(workflow client.WorkflowSlim, _ int) importBlock {
+		return importBlock{Name: workflow.Name, ID: workflow.Id}
+	}), nil
+}
+
+var invalidIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// namer builds valid, unique Terraform resource names from the human readable
+// names of objects in incident.io.
+type namer struct {
+	used map[string]bool
+}
+
+func newNamer() *namer {
+	return &namer{used: map[string]bool{}}
+}
+
+// Name returns an identifier for the given name, suffixing it with _2, _3 and so on
+// until it's one we haven't returned before. We check every candidate, as an object
+// named "foo 2" would otherwise collide with the second object named "foo".
+func (n *namer) Name(name string) string {
+	identifier := strings.Trim(invalidIdentifierChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if identifier == "" || (identifier[0] >= '0' && identifier[0] <= '9') {
+		identifier = "_" + identifier
+	}
+
+	candidate := identifier
+	for suffix := 2; n.used[candidate]; suffix++ {
+		candidate = fmt.Sprintf("%s_%d", identifier, suffix)
+	}
+	n.used[candidate] = true
+
+	return candidate
+}
+
+// clientError builds an error from an unsuccessful API response, explaining when the
+// API key is missing the scopes needed to list a type of resource.
+func clientError(statusCode int, body []byte) error {
+	if statusCode == http.StatusForbidden {
+		return fmt.Errorf(
+			"%s\n\nThe API key does not have the scope required for this request. "+
+				"generate-imports needs a key that can view all the configuration it lists.", string(body))
+	}
+
+	return errors.New(string(body))
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNamer(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		names []string
+		want  []string
+	}{
+		{
+			name:  "non-identifier characters",
+			names: []string{"Payments API", "on-call: EU", "  Spaces  "},
+			want:  []string{"payments_api", "on_call_eu", "spaces"},
+		},
+		{
+			name:  "leading digits and empty names",
+			names: []string{"1st line", "!!!"},
+			want:  []string{"_1st_line", "_"},
+		},
+		{
+			name:  "duplicates",
+			names: []string{"Payments", "payments", "PAYMENTS"},
+			want:  []string{"payments", "payments_2", "payments_3"},
+		},
+		{
+			name:  "duplicate colliding with an existing suffix",
+			names: []string{"foo", "foo 2", "foo"},
+			want:  []string{"foo", "foo_2", "foo_3"},
+		},
+		{
+			name:  "suffix colliding with a later name",
+			names: []string{"foo", "foo", "foo 2"},
+			want:  []string{"foo", "foo_2", "foo_2_2"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			names := newNamer()
+
+			var got []string
+			for _, name := range tc.names {
+				got = append(got, names.Name(name))
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Name() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// incident-apimock serves an in-memory mock of the incident.io API endpoints the
+// provider uses for the catalog, custom fields, severities, incident roles and incident
+// statuses, so modules can be prototyped without an incident.io account:
+//
+//	go run ./cmd/incident-apimock -listen localhost:8080
+//	INCIDENT_ENDPOINT=http://localhost:8080 INCIDENT_API_KEY=apimock terraform apply
+//
+// Everything is forgotten when it exits.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apimock"
+)
+
+var listen = flag.String("listen", "localhost:8080", "address to serve the mock API on")
+
+func main() {
+	flag.Parse()
+
+	fmt.Fprintf(os.Stderr, "incident-apimock: serving on http://%s\n", *listen)
+	if err := http.ListenAndServe(*listen, apimock.New()); err != nil {
+		fmt.Fprintf(os.Stderr, "incident-apimock: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,20 @@
+data "incident_catalog_type" "service" {
+  type_name = "Custom[\"Service\"]"
+}
+
+locals {
+  tier_attribute_id = one([
+    for attribute in data.incident_catalog_type.service.attributes : attribute.id if attribute.name == "Tier"
+  ])
+}
+
+# Find every tier 1 service.
+data "incident_catalog_entries" "tier_one_services" {
+  catalog_type_id = data.incident_catalog_type.service.id
+  attribute_id    = local.tier_attribute_id
+  attribute_value = "1"
+}
+
+output "tier_one_services" {
+  value = { for entry in data.incident_catalog_entries.tier_one_services.catalog_entries : entry.name => entry.id }
+}
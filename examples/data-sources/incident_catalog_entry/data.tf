@@ -0,0 +1,13 @@
+data "incident_catalog_type" "service" {
+  type_name = "Custom[\"Service\"]"
+}
+
+# Look up a service by the ID it has in your service catalog.
+data "incident_catalog_entry" "payments" {
+  catalog_type_id = data.incident_catalog_type.service.id
+  external_id     = "svc-payments"
+}
+
+output "payments_entry_id" {
+  value = data.incident_catalog_entry.payments.id
+}
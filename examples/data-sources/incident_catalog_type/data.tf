@@ -1,20 +1,9 @@
-# Reference the catalog type by its name.
+# Look up a catalog type created by the catalog importer.
 data "incident_catalog_type" "service" {
-  name = "Service"
+  type_name = "Custom[\"Service\"]"
 }
 
-# Now provision the entries for the catalog type.
-resource "incident_catalog_entries" "services" {
-  # This uses the data source to get the ID of the catalog type. It is usually
-  # adviseable that you manage your catalog types in terraform if you are also
-  # managing your entries which normally means this isn't required.
-  id = data.incident_catalog_type.service.id
-
-  entries = {
-    "primary" = {
-      name        = "artist-web"
-      description = "public-websites"
-      tags        = ["java"]
-    }
-  }
+# Find the IDs of its attributes by name, for use in catalog entries.
+output "service_attribute_ids" {
+  value = { for attribute in data.incident_catalog_type.service.attributes : attribute.name => attribute.id }
 }
@@ -0,0 +1,18 @@
+data "incident_catalog_type_schema" "service" {
+  catalog_type_id = incident_catalog_type.service.id
+}
+
+# Refer to attributes by name rather than ID. The plan fails if the catalog type doesn't
+# have an attribute called "Tier".
+resource "incident_catalog_entry" "payments" {
+  catalog_type_id = incident_catalog_type.service.id
+
+  name = "Payments"
+
+  attribute_values = [
+    {
+      attribute = data.incident_catalog_type_schema.service.attribute_ids["Tier"]
+      value     = "1"
+    },
+  ]
+}
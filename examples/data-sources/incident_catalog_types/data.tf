@@ -0,0 +1,13 @@
+data "incident_catalog_types" "all" {}
+
+locals {
+  catalog_types = { for catalog_type in data.incident_catalog_types.all.catalog_types : catalog_type.type_name => catalog_type }
+}
+
+# Fail the plan if a type our module depends on hasn't been imported yet.
+check "service_catalog_type" {
+  assert {
+    condition     = contains(keys(local.catalog_types), "Custom[\"Service\"]")
+    error_message = "The Service catalog type must be imported before applying this module."
+  }
+}
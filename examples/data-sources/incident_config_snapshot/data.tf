@@ -0,0 +1,10 @@
+# Snapshot the configuration of the organisation on every apply, keeping a copy
+# of each version in a bucket so we can restore or clone it later.
+data "incident_config_snapshot" "this" {}
+
+resource "google_storage_bucket_object" "snapshot" {
+  bucket       = "incident-io-snapshots"
+  name         = "config/${data.incident_config_snapshot.this.id}.json"
+  content      = data.incident_config_snapshot.this.json
+  content_type = "application/json"
+}
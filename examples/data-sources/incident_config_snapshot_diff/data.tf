@@ -0,0 +1,18 @@
+# Compare staging against production before promoting configuration, using an
+# aliased provider configured with a staging API key.
+provider "incident" {
+  alias   = "staging"
+  api_key = "<staging-api-key>"
+}
+
+data "incident_config_snapshot" "staging" {
+  provider = incident.staging
+}
+
+data "incident_config_snapshot_diff" "staging_to_production" {
+  source_json = data.incident_config_snapshot.staging.json
+}
+
+output "promotion_changes" {
+  value = data.incident_config_snapshot_diff.staging_to_production.differences
+}
@@ -0,0 +1,13 @@
+data "incident_custom_field" "affected_teams" {
+  name = "Affected Teams"
+}
+
+# Look up the Payments option, to use in workflow conditions.
+data "incident_custom_field_option" "payments" {
+  custom_field_id = data.incident_custom_field.affected_teams.id
+  value           = "Payments"
+}
+
+output "payments_option_id" {
+  value = data.incident_custom_field_option.payments.id
+}
@@ -0,0 +1,19 @@
+data "incident_custom_fields" "all" {}
+
+# Fail the plan if any custom field isn't in title case, such as "Affected Teams".
+check "custom_field_names" {
+  assert {
+    condition = alltrue([
+      for field in data.incident_custom_fields.all.custom_fields : field.name == title(field.name)
+    ])
+    error_message = "Custom field names should be in title case."
+  }
+}
+
+# Find custom fields that aren't managed by this configuration.
+output "unmanaged_custom_fields" {
+  value = [
+    for field in data.incident_custom_fields.all.custom_fields : field.name
+    if !contains([incident_custom_field.affected_teams.id], field.id)
+  ]
+}
@@ -0,0 +1,13 @@
+# Every high priority follow-up that's still to be done.
+data "incident_follow_ups" "outstanding" {
+  status   = ["outstanding"]
+  priority = ["Urgent", "High"]
+}
+
+# Those that haven't been exported to the issue tracker yet, to chase up.
+output "unexported_follow_ups" {
+  value = {
+    for follow_up in data.incident_follow_ups.outstanding.follow_ups :
+    follow_up.id => follow_up.title if follow_up.external_issue_name == null
+  }
+}
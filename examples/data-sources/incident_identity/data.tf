@@ -0,0 +1,15 @@
+data "incident_identity" "catalog" {
+  api_key_family = "catalog"
+}
+
+resource "incident_catalog_type" "service" {
+  name        = "Service"
+  description = "All services that we run across our product"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.incident_identity.catalog.roles, "catalog_editor")
+      error_message = "The API key ${data.incident_identity.catalog.name} needs the catalog_editor scope to manage catalog types."
+    }
+  }
+}
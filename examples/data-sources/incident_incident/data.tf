@@ -0,0 +1,18 @@
+data "incident_incident" "outage" {
+  reference = "INC-123"
+}
+
+locals {
+  incident_lead = one([
+    for assignment in data.incident_incident.outage.role_assignments : assignment.assignee_email
+    if assignment.role_name == "Incident Lead"
+  ])
+}
+
+# Only continue with the runbook once the incident has been closed.
+check "outage_closed" {
+  assert {
+    condition     = data.incident_incident.outage.incident_status_category == "closed"
+    error_message = "INC-123 is still open, ask ${coalesce(local.incident_lead, "the incident lead")} before continuing."
+  }
+}
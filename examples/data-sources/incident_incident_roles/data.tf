@@ -0,0 +1,19 @@
+data "incident_incident_roles" "all" {}
+
+locals {
+  # Incident roles keyed by their shortform, such as "comms".
+  incident_roles = { for role in data.incident_incident_roles.all.incident_roles : role.shortform => role if role.role_type != "reporter" }
+}
+
+# Fail the plan if any of the roles we depend on are missing.
+check "incident_roles" {
+  assert {
+    condition     = alltrue([for shortform in ["lead", "comms", "scribe"] : contains(keys(local.incident_roles), shortform)])
+    error_message = "Expected lead, comms and scribe incident roles to exist."
+  }
+}
+
+# Use the roles with for_each, such as to build a workflow step for each one.
+output "incident_role_ids" {
+  value = { for shortform, role in local.incident_roles : shortform => role.id }
+}
@@ -0,0 +1,6 @@
+data "incident_incident_timestamps" "all" {}
+
+# Pass the ID of a timestamp to configuration managed elsewhere, by name.
+output "impact_started_timestamp_id" {
+  value = data.incident_incident_timestamps.all.ids["Impact started"]
+}
@@ -0,0 +1,21 @@
+data "incident_incident_type" "security" {
+  name = "Security Incident"
+}
+
+# Use the incident type in a workflow condition, so the workflow only runs for
+# security incidents.
+locals {
+  is_security_incident = {
+    subject   = "incident.incident_type"
+    operation = "one_of"
+    param_bindings = [
+      {
+        array_value = [
+          {
+            literal = data.incident_incident_type.security.id
+          },
+        ]
+      },
+    ]
+  }
+}
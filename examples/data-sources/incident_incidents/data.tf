@@ -0,0 +1,19 @@
+data "incident_severities" "all" {}
+
+locals {
+  critical = one([for severity in data.incident_severities.all.severities : severity if severity.name == "Critical"])
+}
+
+# Find any critical incidents that are still being worked on.
+data "incident_incidents" "open_critical" {
+  status_category     = ["triage", "active"]
+  minimum_severity_id = local.critical.id
+}
+
+# Stop the deploy pipeline while there's an open critical incident.
+check "no_open_critical_incidents" {
+  assert {
+    condition     = length(data.incident_incidents.open_critical.incidents) == 0
+    error_message = "There are open critical incidents: ${join(", ", [for incident in data.incident_incidents.open_critical.incidents : incident.reference])}"
+  }
+}
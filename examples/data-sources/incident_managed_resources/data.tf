@@ -0,0 +1,12 @@
+# Report on which catalog types and schedules are managed by Terraform, skipping
+# workflows to avoid loading each of them.
+data "incident_managed_resources" "report" {
+  resource_types = ["catalog_type", "schedule"]
+}
+
+output "unmanaged" {
+  value = [
+    for resource in data.incident_managed_resources.report.resources :
+    "${resource.resource_type}: ${resource.name}" if !resource.managed
+  ]
+}
@@ -0,0 +1,16 @@
+data "incident_schedule" "primary" {
+  name = "Primary on-call"
+}
+
+data "incident_on_call" "primary" {
+  schedule_id = data.incident_schedule.primary.id
+}
+
+locals {
+  # Everyone on call right now, for use with other providers such as generating a
+  # CODEOWNERS file.
+  on_call_emails = compact([for shift in data.incident_on_call.primary.shifts : shift.user_email])
+
+  # When the next handover is.
+  next_handover = try(data.incident_on_call.primary.shifts[0].end_at, null)
+}
@@ -0,0 +1,16 @@
+# Find anything created by Terraform that isn't in the state of this workspace, or
+# the other workspaces whose IDs we're loading from remote state.
+data "incident_orphaned_resources" "orphans" {
+  managed_ids = concat(
+    [
+      incident_catalog_type.service.id,
+      incident_schedule.primary_on_call.id,
+      incident_workflow.autoassign_incident_lead.id,
+    ],
+    data.terraform_remote_state.platform.outputs.managed_ids,
+  )
+}
+
+output "orphans" {
+  value = data.incident_orphaned_resources.orphans.resources
+}
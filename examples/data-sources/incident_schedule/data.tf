@@ -0,0 +1,26 @@
+data "incident_schedule" "primary" {
+  name = "Primary on-call"
+}
+
+# Page whoever is on the primary schedule, which is managed by another team.
+resource "incident_escalation_path" "payments" {
+  name = "Payments"
+
+  path = [
+    {
+      type = "level"
+      level = {
+        targets = [{
+          type    = "schedule"
+          id      = data.incident_schedule.primary.id
+          urgency = "high"
+        }]
+        time_to_ack_seconds = 300
+      }
+    },
+  ]
+}
+
+output "primary_on_call" {
+  value = [for shift in data.incident_schedule.primary.current_shifts : shift.user_name]
+}
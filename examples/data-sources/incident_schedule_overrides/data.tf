@@ -0,0 +1,24 @@
+data "incident_schedule" "primary" {
+  name = "Primary on-call"
+}
+
+data "incident_schedule_overrides" "holidays" {
+  schedule_id  = data.incident_schedule.primary.id
+  window_start = "2024-12-24T00:00:00Z"
+  window_end   = "2025-01-02T00:00:00Z"
+}
+
+# Fail the plan if there's anyone missing over the holidays.
+check "holiday_cover" {
+  assert {
+    condition     = alltrue([for shift in data.incident_schedule_overrides.holidays.shifts : shift.user_id != null])
+    error_message = "The primary on-call schedule has gaps over the holidays."
+  }
+}
+
+output "holiday_overrides" {
+  value = [
+    for override in data.incident_schedule_overrides.holidays.overrides :
+    "${override.user_name}: ${override.start_at} to ${override.end_at}"
+  ]
+}
@@ -0,0 +1,35 @@
+# Find every team's primary schedule, where schedules are named like "Payments: Primary".
+data "incident_schedules" "all" {}
+
+locals {
+  primary_schedules = {
+    for schedule in data.incident_schedules.all.schedules :
+    trimsuffix(schedule.name, ": Primary") => schedule if endswith(schedule.name, ": Primary")
+  }
+}
+
+# Create an escalation path for each team, paging their primary schedule.
+resource "incident_escalation_path" "team" {
+  for_each = local.primary_schedules
+
+  name = each.key
+
+  path = [
+    {
+      type = "level"
+      level = {
+        targets = [{
+          type    = "schedule"
+          id      = each.value.id
+          urgency = "high"
+        }]
+        time_to_ack_seconds = 300
+      }
+    },
+  ]
+}
+
+# Or only list one team's schedules.
+data "incident_schedules" "payments" {
+  name_prefix = "Payments: "
+}
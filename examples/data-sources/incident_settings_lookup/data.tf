@@ -0,0 +1,16 @@
+# Find the default severities and roles this module's workflows depend on.
+data "incident_settings_lookup" "defaults" {
+  severities     = ["Minor", "Major", "Critical"]
+  incident_roles = ["Incident Lead"]
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.missing_severities) == 0 && length(self.missing_incident_roles) == 0
+      error_message = "Missing settings: ${join(", ", concat(tolist(self.missing_severities), tolist(self.missing_incident_roles)))}"
+    }
+  }
+}
+
+output "critical_severity_id" {
+  value = data.incident_settings_lookup.defaults.severity_ids["Critical"]
+}
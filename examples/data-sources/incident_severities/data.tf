@@ -0,0 +1,8 @@
+data "incident_severities" "all" {}
+
+locals {
+  high = one([for severity in data.incident_severities.all.severities : severity if severity.name == "High"])
+
+  # Every severity at least as severe as High, such as for alerting leadership.
+  high_or_above = [for severity in data.incident_severities.all.severities : severity.id if severity.rank >= local.high.rank]
+}
@@ -0,0 +1,10 @@
+# Find the status every organisation has for triaging new incidents.
+data "incident_status" "triage" {
+  category = "triage"
+}
+
+# Find a status by name, checking it's still an active status.
+data "incident_status" "fixing" {
+  name     = "Fixing"
+  category = "live"
+}
@@ -0,0 +1,15 @@
+data "incident_statuses" "all" {}
+
+# The statuses an incident is considered active in, such as for a workflow that should
+# only run on active incidents.
+output "active_status_ids" {
+  value = data.incident_statuses.all.ids_by_category["live"]
+}
+
+# Fail the plan if our first active status isn't the one we expect.
+check "first_active_status" {
+  assert {
+    condition     = [for status in data.incident_statuses.all.statuses : status.name if status.category == "live"][0] == "Investigating"
+    error_message = "Expected Investigating to be the first active status."
+  }
+}
@@ -0,0 +1,23 @@
+# The platform team manages the service entry and its description...
+resource "incident_catalog_entry" "payments" {
+  catalog_type_id = incident_catalog_type.service.id
+  name            = "Payments"
+
+  managed_attributes = [
+    incident_catalog_type_attribute.service_description.id,
+  ]
+
+  attribute_values = [
+    {
+      attribute = incident_catalog_type_attribute.service_description.id
+      value     = "Takes payments from customers"
+    },
+  ]
+}
+
+# ...while the security team manages its tier.
+resource "incident_catalog_entry_attribute_value" "payments_tier" {
+  catalog_entry_id = incident_catalog_entry.payments.id
+  attribute        = incident_catalog_type_attribute.service_tier.id
+  value            = incident_catalog_entry.service_tier["tier_1"].id
+}
@@ -5,4 +5,26 @@ resource "incident_catalog_type" "service_tier" {
   How critical is this service, with tier 1 being the highest and 3 the lowest.
   EOF
   source_repo_url = "https://github.com/mycompany/infrastructure"
+  color           = "orange"
+  icon            = "severity"
+  ranked          = true
+}
+
+# Manage the schema of a type in Terraform, while its entries are pushed by the
+# catalog importer.
+resource "incident_catalog_type" "service" {
+  name                       = "Service"
+  description                = "All services that we run across our product"
+  source_repo_url            = "https://github.com/mycompany/catalog"
+  externally_managed_entries = true
+}
+
+# Define a simple schema inline, rather than with incident_catalog_type_attribute.
+resource "incident_catalog_type" "team" {
+  name        = "Team"
+  description = "The teams that own our services"
+  schema = [
+    { name = "Slack channel", type = "String" },
+    { name = "Members", type = "String", array = true },
+  ]
 }
@@ -13,6 +13,9 @@ resource "incident_catalog_type_attribute" "service_description" {
 
   name = "Description"
   type = "Text"
+
+  # Always show the description first in the dashboard.
+  position = 0
 }
 
 resource "incident_catalog_type_attribute" "service_team" {
@@ -0,0 +1,17 @@
+# Create an Affected Teams custom field that we'll manage options for.
+resource "incident_custom_field" "affected_teams" {
+  name        = "Affected Teams"
+  description = "The teams that are affected by this incident."
+  field_type  = "multi_select"
+}
+
+# Manage all the teams against the parent custom field, in the order they should
+# be displayed. Any options not in this list will be removed.
+resource "incident_custom_field_options" "teams" {
+  custom_field_id = incident_custom_field.affected_teams.id
+  options = [
+    { value = "Payments" },
+    { value = "Dashboard" },
+    { value = "API" },
+  ]
+}
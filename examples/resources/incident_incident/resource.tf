@@ -0,0 +1,7 @@
+# Create a standing test incident for a game day, using the "Minor" severity.
+resource "incident_incident" "game_day" {
+  name        = "Game day: database failover"
+  summary     = "Practising a failover of the primary database to its replica."
+  mode        = "test"
+  severity_id = incident_severity.minor.id
+}
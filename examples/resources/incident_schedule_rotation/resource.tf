@@ -0,0 +1,57 @@
+# The primary schedule is owned by the SRE team...
+resource "incident_schedule" "primary_on_call" {
+  name     = "Primary On-call"
+  timezone = "Europe/London"
+
+  # ...who leave the "payments" rotation to the payments team
+  managed_rotations = ["sre"]
+  rotations = [{
+    id   = "sre"
+    name = "SRE"
+    versions = [
+      {
+        handover_start_at = "2024-05-01T12:54:13Z"
+        users             = [data.incident_user.martha.id]
+        layers = [
+          {
+            id   = "primary"
+            name = "Primary"
+          }
+        ]
+        handovers = [
+          {
+            interval_type = "weekly"
+            interval      = 1
+          }
+        ]
+      },
+    ]
+  }]
+}
+
+# This can live in the payments team's own Terraform state, as long as it isn't
+# applied at the same time as other states that change this schedule's rotations
+resource "incident_schedule_rotation" "payments" {
+  schedule_id = incident_schedule.primary_on_call.id
+  rotation_id = "payments"
+  name        = "Payments"
+
+  versions = [
+    {
+      handover_start_at = "2024-05-01T12:54:13Z"
+      users             = [data.incident_user.rory.id]
+      layers = [
+        {
+          id   = "primary"
+          name = "Primary"
+        }
+      ]
+      handovers = [
+        {
+          interval_type = "daily"
+          interval      = 1
+        }
+      ]
+    },
+  ]
+}
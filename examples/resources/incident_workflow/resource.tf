@@ -1,6 +1,7 @@
 # This is a workflow that automatically assigns the incident lead role to the user who acked an escalation.
 resource "incident_workflow" "autoassign_incident_lead" {
   name    = "Auto-assign incident leader"
+  folder  = "On-call"
   trigger = "escalation.acked"
   expressions = [
   ]
@@ -0,0 +1,389 @@
+// Package apimock is a minimal, in-memory implementation of the incident.io API
+// endpoints the provider uses to manage the catalog, custom fields, severities, incident
+// roles and incident statuses.
+//
+// It exists so modules can be prototyped, and acceptance tests run, without an
+// incident.io organisation. It stores whatever it's sent and makes no attempt to
+// validate requests like the real API does, so passing against the mock doesn't
+// guarantee passing against the API.
+package apimock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/lo"
+)
+
+// object is a single API object, such as a severity, as it would be returned by the API.
+type object = map[string]any
+
+// collection stores the objects behind one API resource, such as /v1/severities.
+type collection struct {
+	// path is the path of the list and create endpoints, with show, update and delete
+	// under path/{id}.
+	path string
+	// singular and plural are the keys objects are wrapped in for responses about one
+	// object, and lists of objects.
+	singular, plural string
+	// filter is the query parameter list requests must filter by, if any.
+	filter string
+	// defaults fills in the fields the API sets on create, such as rank.
+	defaults func(s *Server, obj object)
+
+	ids     []string
+	objects map[string]object
+}
+
+// Server is an http.Handler that serves the mock API.
+type Server struct {
+	mu sync.Mutex
+
+	catalogTypes       *collection
+	catalogEntries     *collection
+	customFields       *collection
+	customFieldOptions *collection
+	severities         *collection
+	incidentRoles      *collection
+	incidentStatuses   *collection
+}
+
+var _ http.Handler = &Server{}
+
+// New returns a mock API server with no configuration.
+func New() *Server {
+	s := &Server{
+		catalogTypes: &collection{
+			path: "/v2/catalog_types", singular: "catalog_type", plural: "catalog_types",
+			defaults: func(s *Server, obj object) {
+				setDefault(obj, "type_name", fmt.Sprintf(`Custom["%s"]`, nonAlphanumeric.ReplaceAllString(fmt.Sprint(obj["name"]), "")))
+				setDefault(obj, "annotations", map[string]string{})
+				setDefault(obj, "color", "yellow")
+				setDefault(obj, "icon", "box")
+				setDefault(obj, "ranked", false)
+				setDefault(obj, "is_editable", true)
+				obj["schema"] = object{"attributes": []any{}, "version": 1}
+			},
+		},
+		catalogEntries: &collection{
+			path: "/v2/catalog_entries", singular: "catalog_entry", plural: "catalog_entries",
+			filter: "catalog_type_id",
+			defaults: func(s *Server, obj object) {
+				setDefault(obj, "aliases", []string{})
+				setDefault(obj, "attribute_values", map[string]any{})
+				setDefault(obj, "rank", 0)
+			},
+		},
+		customFields: &collection{
+			path: "/v2/custom_fields", singular: "custom_field", plural: "custom_fields",
+		},
+		customFieldOptions: &collection{
+			path: "/v1/custom_field_options", singular: "custom_field_option", plural: "custom_field_options",
+			filter: "custom_field_id",
+			defaults: func(s *Server, obj object) {
+				setDefault(obj, "sort_key", 1000)
+			},
+		},
+		severities: &collection{
+			path: "/v1/severities", singular: "severity", plural: "severities",
+			defaults: func(s *Server, obj object) {
+				setDefault(obj, "rank", len(s.severities.ids)+1)
+			},
+		},
+		incidentRoles: &collection{
+			path: "/v2/incident_roles", singular: "incident_role", plural: "incident_roles",
+			defaults: func(s *Server, obj object) {
+				obj["role_type"] = "custom"
+			},
+		},
+		incidentStatuses: &collection{
+			path: "/v1/incident_statuses", singular: "incident_status", plural: "incident_statuses",
+			defaults: func(s *Server, obj object) {
+				setDefault(obj, "rank", len(s.incidentStatuses.ids)+1)
+			},
+		},
+	}
+
+	for _, c := range s.collections() {
+		c.objects = map[string]object{}
+	}
+
+	return s
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^A-Za-z0-9]`)
+
+func (s *Server) collections() []*collection {
+	return []*collection{
+		s.catalogTypes,
+		s.catalogEntries,
+		s.customFields,
+		s.customFieldOptions,
+		s.severities,
+		s.incidentRoles,
+		s.incidentStatuses,
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "authentication_error", "Missing API key")
+		return
+	}
+
+	if r.URL.Path == "/v1/identity" && r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, object{"identity": object{
+			"name":          "apimock",
+			"roles":         []string{"viewer", "global_access", "manage_settings", "catalog_editor"},
+			"dashboard_url": fmt.Sprintf("http://%s/dashboard", r.Host),
+		}})
+		return
+	}
+
+	if id, ok := matchAction(r.URL.Path, s.catalogTypes.path, "update_schema"); ok && r.Method == http.MethodPost {
+		s.updateCatalogTypeSchema(w, r, id)
+		return
+	}
+
+	for _, c := range s.collections() {
+		if r.URL.Path == c.path {
+			switch r.Method {
+			case http.MethodGet:
+				s.list(w, r, c)
+			case http.MethodPost:
+				s.create(w, r, c)
+			default:
+				writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Method not allowed")
+			}
+			return
+		}
+
+		if id, ok := strings.CutPrefix(r.URL.Path, c.path+"/"); ok && !strings.Contains(id, "/") {
+			obj, found := c.objects[id]
+			if !found {
+				writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("No %s with id %s", c.singular, id))
+				return
+			}
+
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, http.StatusOK, object{c.singular: obj})
+			case http.MethodPut:
+				s.update(w, r, c, obj)
+			case http.MethodDelete:
+				c.ids = lo.Without(c.ids, id)
+				delete(c.objects, id)
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "Method not allowed")
+			}
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("The mock API doesn't implement %s %s", r.Method, r.URL.Path))
+}
+
+// list returns a page of objects, after the object with the ID given by the after
+// query parameter, as the API's paginated list endpoints do.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, c *collection) {
+	query := r.URL.Query()
+	objects := lo.Map(c.ids, func(id string, _ int) object {
+		return c.objects[id]
+	})
+
+	if c.filter != "" {
+		value := query.Get(c.filter)
+		if value == "" {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", fmt.Sprintf("%s is required", c.filter))
+			return
+		}
+		objects = lo.Filter(objects, func(obj object, _ int) bool {
+			return obj[c.filter] == value
+		})
+	}
+
+	resp := object{}
+	if query.Has("page_size") {
+		pageSize, err := strconv.Atoi(query.Get("page_size"))
+		if err != nil || pageSize < 1 {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", "page_size must be a positive integer")
+			return
+		}
+
+		objects = paginate(objects, query.Get("after"), pageSize)
+		meta := object{"page_size": pageSize}
+		if len(objects) > 0 {
+			meta["after"] = objects[len(objects)-1]["id"]
+		}
+		resp["pagination_meta"] = meta
+	}
+
+	// Listing entries also returns their catalog type.
+	if c == s.catalogEntries {
+		catalogType, ok := s.catalogTypes.objects[query.Get("catalog_type_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "not_found", "No catalog_type with that id")
+			return
+		}
+		resp["catalog_type"] = catalogType
+	}
+
+	resp[c.plural] = objects
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func paginate(objects []object, after string, pageSize int) []object {
+	if after != "" {
+		_, idx, found := lo.FindIndexOf(objects, func(obj object) bool {
+			return obj["id"] == after
+		})
+		if !found {
+			return []object{}
+		}
+		objects = objects[idx+1:]
+	}
+
+	if pageSize < len(objects) {
+		objects = objects[:pageSize]
+	}
+
+	return objects
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, c *collection) {
+	obj, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	obj["id"] = ulid.Make().String()
+	obj["created_at"] = now
+	obj["updated_at"] = now
+	if c.defaults != nil {
+		c.defaults(s, obj)
+	}
+
+	c.ids = append(c.ids, obj["id"].(string))
+	c.objects[obj["id"].(string)] = obj
+	writeJSON(w, http.StatusCreated, object{c.singular: obj})
+}
+
+// update replaces the fields of the object that were sent in the request, keeping the
+// rest as they were.
+func (s *Server) update(w http.ResponseWriter, r *http.Request, c *collection, obj object) {
+	fields, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	for _, readOnly := range []string{"id", "created_at", "schema"} {
+		delete(fields, readOnly)
+	}
+	for key, value := range fields {
+		obj[key] = value
+	}
+	obj["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+
+	writeJSON(w, http.StatusOK, object{c.singular: obj})
+}
+
+// updateCatalogTypeSchema replaces a catalog type's attributes, giving an ID to any
+// that don't have one yet.
+func (s *Server) updateCatalogTypeSchema(w http.ResponseWriter, r *http.Request, id string) {
+	catalogType, ok := s.catalogTypes.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("No catalog_type with id %s", id))
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	attributes, _ := body["attributes"].([]any)
+	for _, attribute := range attributes {
+		if attribute, ok := attribute.(map[string]any); ok {
+			setDefault(attribute, "id", ulid.Make().String())
+			setDefault(attribute, "mode", "manual")
+		}
+	}
+
+	schema := catalogType["schema"].(object)
+	catalogType["schema"] = object{
+		"attributes": lo.Ternary(attributes == nil, []any{}, attributes),
+		"version":    toInt(schema["version"]) + 1,
+	}
+	catalogType["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+
+	writeJSON(w, http.StatusOK, object{"catalog_type": catalogType})
+}
+
+// matchAction returns the ID from paths like {prefix}/{id}/actions/{action}.
+func matchAction(path, prefix, action string) (string, bool) {
+	id, ok := strings.CutPrefix(path, prefix+"/")
+	if !ok {
+		return "", false
+	}
+	id, ok = strings.CutSuffix(id, "/actions/"+action)
+	if !ok || strings.Contains(id, "/") {
+		return "", false
+	}
+
+	return id, true
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) (object, bool) {
+	var body object
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", fmt.Sprintf("Unable to parse request body: %s", err))
+		return nil, false
+	}
+
+	return body, true
+}
+
+func setDefault(obj object, key string, value any) {
+	if existing, ok := obj[key]; !ok || existing == nil {
+		obj[key] = value
+	}
+}
+
+func toInt(value any) int {
+	switch value := value.(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	default:
+		return 0
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError responds with an error in the same shape as the API, so the provider
+// reports it the same way.
+func writeError(w http.ResponseWriter, status int, errorType, message string) {
+	writeJSON(w, status, object{
+		"type":   errorType,
+		"status": status,
+		"errors": []object{{"code": errorType, "message": message}},
+	})
+}
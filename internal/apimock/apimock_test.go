@@ -0,0 +1,163 @@
+package apimock
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func newTestClient(t *testing.T) *client.ClientWithResponses {
+	server := httptest.NewServer(New())
+	t.Cleanup(server.Close)
+
+	bearerTokenProvider, err := securityprovider.NewSecurityProviderBearerToken("apimock")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiClient, err := client.NewClientWithResponses(server.URL, client.WithRequestEditorFn(bearerTokenProvider.Intercept))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return apiClient
+}
+
+func TestSeverities(t *testing.T) {
+	ctx := context.Background()
+	apiClient := newTestClient(t)
+
+	created, err := apiClient.SeveritiesV1CreateWithResponse(ctx, client.SeveritiesV1CreateJSONRequestBody{
+		Name:        "Minor",
+		Description: "Issues with low impact",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.StatusCode() != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", created.StatusCode(), created.Body)
+	}
+	severity := created.JSON201.Severity
+	if severity.Id == "" || severity.Rank != 1 {
+		t.Errorf("expected an ID and a default rank of 1, got %+v", severity)
+	}
+
+	updated, err := apiClient.SeveritiesV1UpdateWithResponse(ctx, severity.Id, client.SeveritiesV1UpdateJSONRequestBody{
+		Name:        "Minor",
+		Description: "Issues with minimal impact",
+		Rank:        lo.ToPtr(int64(5)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := updated.JSON200.Severity; got.Description != "Issues with minimal impact" || got.Rank != 5 {
+		t.Errorf("expected update to apply, got %+v", got)
+	}
+
+	listed, err := apiClient.SeveritiesV1ListWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := len(listed.JSON200.Severities); count != 1 {
+		t.Errorf("expected 1 severity, got %d", count)
+	}
+
+	deleted, err := apiClient.SeveritiesV1DeleteWithResponse(ctx, severity.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.StatusCode() >= 400 {
+		t.Fatalf("expected delete to succeed, got %d: %s", deleted.StatusCode(), deleted.Body)
+	}
+
+	shown, err := apiClient.SeveritiesV1ShowWithResponse(ctx, severity.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shown.StatusCode() != http.StatusNotFound {
+		t.Errorf("expected 404 after delete, got %d", shown.StatusCode())
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	ctx := context.Background()
+	apiClient := newTestClient(t)
+
+	created, err := apiClient.CatalogV2CreateTypeWithResponse(ctx, client.CatalogV2CreateTypeJSONRequestBody{
+		Name:        "Service",
+		Description: "Services we run",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	catalogType := created.JSON201.CatalogType
+	if catalogType.TypeName != `Custom["Service"]` {
+		t.Errorf("expected a default type name, got %s", catalogType.TypeName)
+	}
+
+	updated, err := apiClient.CatalogV2UpdateTypeSchemaWithResponse(ctx, catalogType.Id, client.CatalogV2UpdateTypeSchemaJSONRequestBody{
+		Version:    catalogType.Schema.Version,
+		Attributes: []client.CatalogTypeAttributePayloadV2{{Name: "Tier", Type: "String"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := updated.JSON200.CatalogType.Schema
+	if len(schema.Attributes) != 1 || schema.Attributes[0].Id == "" || schema.Version != catalogType.Schema.Version+1 {
+		t.Fatalf("expected one attribute with an ID and a new version, got %+v", schema)
+	}
+
+	for _, name := range []string{"Payments", "Search", "Dashboard"} {
+		_, err := apiClient.CatalogV2CreateEntryWithResponse(ctx, client.CatalogV2CreateEntryJSONRequestBody{
+			CatalogTypeId: catalogType.Id,
+			Name:          name,
+			AttributeValues: map[string]client.EngineParamBindingPayloadV2{
+				schema.Attributes[0].Id: {Value: &client.EngineParamBindingValuePayloadV2{Literal: lo.ToPtr("1")}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Page through entries the way the provider does, until we get an empty page.
+	var (
+		names []string
+		after *string
+	)
+	for {
+		listed, err := apiClient.CatalogV2ListEntriesWithResponse(ctx, &client.CatalogV2ListEntriesParams{
+			CatalogTypeId: catalogType.Id,
+			PageSize:      lo.ToPtr(int64(2)),
+			After:         after,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if listed.JSON200.CatalogType.Id != catalogType.Id {
+			t.Fatalf("expected entries to be listed with their catalog type, got %+v", listed.JSON200.CatalogType)
+		}
+
+		entries := listed.JSON200.CatalogEntries
+		if len(entries) == 0 {
+			break
+		}
+		for _, entry := range entries {
+			names = append(names, entry.Name)
+			if binding := entry.AttributeValues[schema.Attributes[0].Id]; binding.Value == nil || lo.FromPtr(binding.Value.Literal) != "1" {
+				t.Errorf("expected attribute value to be stored, got %+v", binding)
+			}
+		}
+		after = lo.ToPtr(entries[len(entries)-1].Id)
+	}
+
+	if expected := []string{"Payments", "Search", "Dashboard"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
@@ -62,3 +62,18 @@ func Docstring(definitionName, propertyName string) string {
 
 	return p.Value.Description
 }
+
+// Enum returns the values a property can take, in the order the schema lists them.
+func Enum(definitionName, propertyName string) []string {
+	p := Property(definitionName, propertyName)
+	if p.Value == nil || len(p.Value.Enum) == 0 {
+		panic(fmt.Sprintf("property %s of %s has no enum", propertyName, definitionName))
+	}
+
+	values := make([]string, 0, len(p.Value.Enum))
+	for _, value := range p.Value.Enum {
+		values = append(values, fmt.Sprint(value))
+	}
+
+	return values
+}
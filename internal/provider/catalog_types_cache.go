@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+// catalogTypesCache holds the result of listing catalog types for the duration of a
+// plan or apply, as configs with many catalog type data sources would otherwise list
+// every type once per data source.
+//
+// Anything that changes a catalog type or its schema must call invalidate, so later
+// lookups see the change. A nil cache is valid, and always lists from the API.
+type catalogTypesCache struct {
+	mutex        sync.Mutex
+	catalogTypes []client.CatalogTypeV2
+}
+
+// list returns all catalog types, loading them from the API if we haven't already.
+// Callers waiting on the lock share the result of a single request.
+func (c *catalogTypesCache) list(ctx context.Context, apiClient *client.ClientWithResponses) ([]client.CatalogTypeV2, error) {
+	if c != nil {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if c.catalogTypes != nil {
+			return c.catalogTypes, nil
+		}
+	}
+
+	result, err := apiClient.CatalogV2ListTypesWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c != nil {
+		c.catalogTypes = result.JSON200.CatalogTypes
+	}
+
+	return result.JSON200.CatalogTypes, nil
+}
+
+// get returns the catalog type with the given ID from the cached list, falling back to
+// the API for types that were created since we listed them.
+func (c *catalogTypesCache) get(ctx context.Context, apiClient *client.ClientWithResponses, id string) (*client.CatalogTypeV2, error) {
+	catalogTypes, err := c.list(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+	if catalogType, ok := lo.Find(catalogTypes, func(catalogType client.CatalogTypeV2) bool {
+		return catalogType.Id == id
+	}); ok {
+		return &catalogType, nil
+	}
+
+	result, err := apiClient.CatalogV2ShowTypeWithResponse(ctx, id)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.JSON200.CatalogType, nil
+}
+
+// invalidate drops the cached catalog types, so the next call to list loads them again.
+func (c *catalogTypesCache) invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.catalogTypes = nil
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestCatalogTypesCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"catalog_types": [{"id": "01GW2G3V0S59R238FAHPDS1R66", "name": "Service"}]}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list := func(cache *catalogTypesCache) {
+		t.Helper()
+		catalogTypes, err := cache.list(context.Background(), apiClient)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(catalogTypes) != 1 || catalogTypes[0].Name != "Service" {
+			t.Fatalf("unexpected catalog types: %v", catalogTypes)
+		}
+	}
+
+	cache := &catalogTypesCache{}
+	list(cache)
+	list(cache)
+	if requests != 1 {
+		t.Errorf("expected a single request while cached, got %d", requests)
+	}
+
+	cache.invalidate()
+	list(cache)
+	if requests != 2 {
+		t.Errorf("expected a new request after invalidating, got %d", requests)
+	}
+
+	var uncached *catalogTypesCache
+	list(uncached)
+	uncached.invalidate()
+	if requests != 3 {
+		t.Errorf("expected a nil cache to always make a request, got %d", requests)
+	}
+}
+
+func TestCatalogTypesCacheGet(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/catalog_types":
+			_, _ = w.Write([]byte(`{"catalog_types": [{"id": "01GW2G3V0S59R238FAHPDS1R66", "name": "Service"}]}`))
+		case "/v2/catalog_types/01GW2G3V0S59R238FAHPDS1R67":
+			_, _ = w.Write([]byte(`{"catalog_type": {"id": "01GW2G3V0S59R238FAHPDS1R67", "name": "Team"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := &catalogTypesCache{}
+	for _, tc := range []struct {
+		id               string
+		expectedName     string
+		expectedRequests int
+	}{
+		{"01GW2G3V0S59R238FAHPDS1R66", "Service", 1},
+		{"01GW2G3V0S59R238FAHPDS1R66", "Service", 1},
+		{"01GW2G3V0S59R238FAHPDS1R67", "Team", 2},
+	} {
+		catalogType, err := cache.get(context.Background(), apiClient, tc.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if catalogType.Name != tc.expectedName {
+			t.Errorf("expected %s, got %s", tc.expectedName, catalogType.Name)
+		}
+		if len(requests) != tc.expectedRequests {
+			t.Errorf("expected %d requests, got %v", tc.expectedRequests, requests)
+		}
+	}
+
+	if _, err := cache.get(context.Background(), apiClient, "missing"); err == nil {
+		t.Error("expected an error for a missing catalog type")
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// Multi-value attributes should be sets when the order of their values doesn't mean
+// anything, so that the API or config reordering them never produces a diff, and
+// lists when the order matters (such as a path through catalog attributes).
+//
+// These helpers convert both to and from slices of strings, so resources treat them
+// consistently whichever semantics they use.
+
+// stringCollection is satisfied by both types.List and types.Set.
+type stringCollection interface {
+	IsNull() bool
+	IsUnknown() bool
+	ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics
+}
+
+// stringElements returns the values of a list or set of strings, or nil if it is
+// null or unknown.
+func stringElements(ctx context.Context, collection stringCollection) ([]string, diag.Diagnostics) {
+	if collection.IsNull() || collection.IsUnknown() {
+		return nil, nil
+	}
+
+	var values []string
+	diags := collection.ElementsAs(ctx, &values, false)
+
+	return values, diags
+}
+
+// stringListValue builds a list of strings, for values where order matters.
+func stringListValue(values []string) types.List {
+	return types.ListValueMust(types.StringType, stringAttrValues(values))
+}
+
+// stringSetValue builds a set of strings, for values where order is irrelevant.
+func stringSetValue(values []string) types.Set {
+	return types.SetValueMust(types.StringType, stringAttrValues(values))
+}
+
+func stringAttrValues(values []string) []attr.Value {
+	return lo.Map(values, func(value string, _ int) attr.Value {
+		return types.StringValue(value)
+	})
+}
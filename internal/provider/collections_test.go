@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringElements(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name       string
+		collection stringCollection
+		want       []string
+	}{
+		{"list", stringListValue([]string{"b", "a"}), []string{"b", "a"}},
+		{"set", stringSetValue([]string{"a"}), []string{"a"}},
+		{"null list", types.ListNull(types.StringType), nil},
+		{"unknown set", types.SetUnknown(types.StringType), nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := stringElements(ctx, tc.collection)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringSetValue(t *testing.T) {
+	if !stringSetValue([]string{"a", "b"}).Equal(stringSetValue([]string{"b", "a"})) {
+		t.Error("expected sets with the same values in a different order to be equal")
+	}
+	if stringListValue([]string{"a", "b"}).Equal(stringListValue([]string{"b", "a"})) {
+		t.Error("expected lists with the same values in a different order to differ")
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConformanceSeverityResource(t *testing.T) {
+	_, read, updated := replayResourceCRUD(t, NewIncidentSeverityResource(), "severity",
+		&IncidentSeverityResourceModel{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue("Conformance"),
+			Description: types.StringValue("Created by the conformance tests."),
+			Rank:        types.Int64Unknown(),
+		},
+		&IncidentSeverityResourceModel{
+			Name:        types.StringValue("Conformance (updated)"),
+			Description: types.StringValue("Updated by the conformance tests."),
+			Rank:        types.Int64Value(2),
+		},
+	)
+
+	requireStateAttribute(t, read, "id", "01FCNDV6P870EA6S7TK1DSYDG0")
+	requireStateAttribute(t, read, "name", "Conformance")
+	requireStateAttribute(t, updated, "name", "Conformance (updated)")
+}
+
+func TestConformanceRoleResource(t *testing.T) {
+	_, read, updated := replayResourceCRUD(t, NewIncidentRoleResource(), "incident_role",
+		&IncidentRoleResourceModel{
+			ID:           types.StringUnknown(),
+			Name:         types.StringValue("Conformance"),
+			Description:  types.StringValue("Created by the conformance tests."),
+			Instructions: types.StringValue("Run the conformance tests."),
+			Shortform:    types.StringValue("conformance"),
+		},
+		&IncidentRoleResourceModel{
+			Name:         types.StringValue("Conformance (updated)"),
+			Description:  types.StringValue("Updated by the conformance tests."),
+			Instructions: types.StringValue("Run the conformance tests again."),
+			Shortform:    types.StringValue("conformance"),
+		},
+	)
+
+	requireStateAttribute(t, read, "id", "01FH5TZRWMNAFB0DZ23FD1TV96")
+	requireStateAttribute(t, read, "instructions", "Run the conformance tests.")
+	requireStateAttribute(t, updated, "name", "Conformance (updated)")
+}
+
+func TestConformanceCustomFieldResource(t *testing.T) {
+	_, read, updated := replayResourceCRUD(t, NewIncidentCustomFieldResource(), "custom_field",
+		&IncidentCustomFieldResourceModel{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue("Conformance"),
+			Description: types.StringValue("Created by the conformance tests."),
+			FieldType:   types.StringValue("single_select"),
+		},
+		&IncidentCustomFieldResourceModel{
+			Name:        types.StringValue("Conformance (updated)"),
+			Description: types.StringValue("Updated by the conformance tests."),
+			FieldType:   types.StringValue("single_select"),
+		},
+	)
+
+	requireStateAttribute(t, read, "id", "01GBSQF3FHF7FWZQNWGHAVQ804")
+	requireStateAttribute(t, read, "field_type", "single_select")
+	requireStateAttribute(t, updated, "description", "Updated by the conformance tests.")
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+// DashboardURL builds links to pages in the incident.io dashboard. We look up the
+// dashboard URL for the organisation that owns the API key the first time we need it,
+// then reuse it for the rest of the run.
+type DashboardURL struct {
+	client *client.ClientWithResponses
+
+	once sync.Once
+	base string
+}
+
+func NewDashboardURL(client *client.ClientWithResponses) *DashboardURL {
+	return &DashboardURL{client: client}
+}
+
+// Build returns a link to the dashboard page at the given path, or null if we were
+// unable to find the dashboard URL.
+func (d *DashboardURL) Build(ctx context.Context, segments ...string) types.String {
+	if d == nil {
+		return types.StringNull()
+	}
+
+	d.once.Do(func() {
+		result, err := d.client.UtilitiesV1IdentityWithResponse(ctx)
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("unable to load dashboard URL, app_url will be null: %s", err))
+			return
+		}
+
+		d.base = strings.TrimSuffix(result.JSON200.Identity.DashboardUrl, "/")
+	})
+
+	if d.base == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(d.base + "/" + strings.Join(lo.Map(segments, func(segment string, _ int) string {
+		return url.PathEscape(segment)
+	}), "/"))
+}
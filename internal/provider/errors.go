@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// clientError builds an error from an unsuccessful API response. If the API key
+// is missing the scopes needed for the request we say so explicitly, as this is
+// easy to hit when using a read-only key with resources rather than data sources.
+func clientError(statusCode int, body []byte) error {
+	if statusCode == http.StatusForbidden {
+		return fmt.Errorf(
+			"%s\n\nThe API key used by the provider does not have the scope required for this request. "+
+				"Read-only API keys can be used with data sources, but managing resources requires a key "+
+				"that can create, edit and delete the relevant configuration.", string(body))
+	}
+
+	return fmt.Errorf(string(body))
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentCatalogEntriesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentCatalogEntriesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentCatalogEntriesDataSource{}
+)
+
+func NewIncidentCatalogEntriesDataSource() datasource.DataSource {
+	return &IncidentCatalogEntriesDataSource{}
+}
+
+type IncidentCatalogEntriesDataSource struct {
+	client    *client.ClientWithResponses
+	dashboard *DashboardURL
+}
+
+type IncidentCatalogEntriesDataSourceModel struct {
+	CatalogTypeID  types.String                            `tfsdk:"catalog_type_id"`
+	AttributeID    types.String                            `tfsdk:"attribute_id"`
+	AttributeValue types.String                            `tfsdk:"attribute_value"`
+	CatalogEntries []IncidentCatalogEntriesDataSourceEntry `tfsdk:"catalog_entries"`
+	Limit          types.Int64                             `tfsdk:"limit"`
+	After          types.String                            `tfsdk:"after"`
+	TotalCount     types.Int64                             `tfsdk:"total_count"`
+}
+
+type IncidentCatalogEntriesDataSourceEntry struct {
+	ID              types.String                 `tfsdk:"id"`
+	ExternalID      types.String                 `tfsdk:"external_id"`
+	Name            types.String                 `tfsdk:"name"`
+	Aliases         types.List                   `tfsdk:"aliases"`
+	Rank            types.Int64                  `tfsdk:"rank"`
+	AttributeValues []CatalogEntryAttributeValue `tfsdk:"attribute_values"`
+	AppURL          types.String                 `tfsdk:"app_url"`
+}
+
+func (i *IncidentCatalogEntriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entries"
+}
+
+func (i *IncidentCatalogEntriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists the entries of a catalog type, optionally only those with a given attribute value. Use it with `for_each` to create resources for each entry, such as a status page component for each service.\n\nWithout an attribute filter, only the pages of entries needed for `limit` are loaded, but `total_count` isn't known. With one, every entry is loaded and filtered by the provider.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"catalog_type_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "catalog_type_id"),
+				Required:            true,
+			},
+			"attribute_id": schema.StringAttribute{
+				MarkdownDescription: "Only return entries where the attribute with this ID has `attribute_value`. Array attributes match if any of their values do.",
+				Optional:            true,
+			},
+			"attribute_value": schema.StringAttribute{
+				MarkdownDescription: "The value the attribute given by `attribute_id` must have.",
+				Optional:            true,
+			},
+			"catalog_entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching catalog entries, in the order the API returns them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"external_id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "external_id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "name"),
+							Computed:            true,
+						},
+						"aliases": schema.ListAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "aliases"),
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"rank": schema.Int64Attribute{
+							MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "rank"),
+							Computed:            true,
+						},
+						"app_url": schema.StringAttribute{
+							MarkdownDescription: "Link to this catalog entry in the incident.io dashboard.",
+							Computed:            true,
+						},
+						"attribute_values": schema.ListNestedAttribute{
+							MarkdownDescription: "The entry's attribute values, ordered by attribute ID.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"attribute": schema.StringAttribute{
+										MarkdownDescription: "The ID of this attribute.",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value of this attribute, if it's a scalar.",
+										Computed:            true,
+									},
+									"array_value": schema.ListAttribute{
+										MarkdownDescription: "The values of this attribute, if it's an array.",
+										ElementType:         types.StringType,
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentCatalogEntriesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IncidentCatalogEntriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AttributeID.IsNull() != data.AttributeValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("attribute_value"),
+			"Incomplete attribute filter",
+			"attribute_id and attribute_value must be set together.",
+		)
+	}
+}
+
+func (i *IncidentCatalogEntriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyCatalog)
+	i.dashboard = client.DashboardURL
+}
+
+func (i *IncidentCatalogEntriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentCatalogEntriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		entries []client.CatalogEntryV2
+		err     error
+	)
+	if data.AttributeID.IsNull() {
+		// Without a filter we only need the pages we're returning, but the API doesn't
+		// tell us how many entries there are in total.
+		data.TotalCount = types.Int64Null()
+		entries, err = paginate(ctx, data.Limit, data.After, func(ctx context.Context, pageSize int64, after *string) ([]client.CatalogEntryV2, *string, error) {
+			result, err := i.client.CatalogV2ListEntriesWithResponse(ctx, &client.CatalogV2ListEntriesParams{
+				CatalogTypeId: data.CatalogTypeID.ValueString(),
+				PageSize:      &pageSize,
+				After:         after,
+			})
+			if err == nil && result.StatusCode() >= 400 {
+				err = clientError(result.StatusCode(), result.Body)
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+
+			next := result.JSON200.PaginationMeta.After
+			if lo.FromPtr(next) == "" {
+				next = nil
+			}
+
+			return result.JSON200.CatalogEntries, next, nil
+		})
+	} else {
+		// The API can't filter by attribute value, so we load every entry and filter them
+		// ourselves, meaning limit and after apply to the filtered entries.
+		_, entries, err = listCatalogEntries(ctx, i.client, data.CatalogTypeID.ValueString())
+		entries = lo.Filter(entries, func(entry client.CatalogEntryV2, _ int) bool {
+			return catalogEntryHasAttributeValue(entry, data.AttributeID.ValueString(), data.AttributeValue.ValueString())
+		})
+		data.TotalCount = types.Int64Value(int64(len(entries)))
+
+		entries = paginateSlice(entries, func(entry client.CatalogEntryV2) string {
+			return entry.Id
+		}, data.Limit, data.After)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list catalog entries, got error: %s", err))
+		return
+	}
+
+	data.CatalogEntries = lo.Map(entries, func(entry client.CatalogEntryV2, _ int) IncidentCatalogEntriesDataSourceEntry {
+		model := new(IncidentCatalogEntryResource).buildModel(entry, nil)
+
+		return IncidentCatalogEntriesDataSourceEntry{
+			ID:              model.ID,
+			ExternalID:      types.StringPointerValue(entry.ExternalId),
+			Name:            model.Name,
+			Aliases:         model.Aliases,
+			Rank:            model.Rank,
+			AttributeValues: model.AttributeValues,
+			AppURL:          i.dashboard.Build(ctx, "catalog", entry.CatalogTypeId, entry.Id),
+		}
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// catalogEntryHasAttributeValue returns true if the entry's value for the attribute is
+// the given literal, or for array attributes, if any of its values are.
+func catalogEntryHasAttributeValue(entry client.CatalogEntryV2, attributeID, value string) bool {
+	binding, ok := entry.AttributeValues[attributeID]
+	if !ok {
+		return false
+	}
+
+	values := []client.CatalogEntryEngineParamBindingValueV2{}
+	if binding.Value != nil {
+		values = append(values, *binding.Value)
+	}
+	if binding.ArrayValue != nil {
+		values = append(values, *binding.ArrayValue...)
+	}
+
+	return lo.ContainsBy(values, func(bindingValue client.CatalogEntryEngineParamBindingValueV2) bool {
+		return lo.FromPtr(bindingValue.Literal) == value
+	})
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestIncidentCatalogEntriesDataSourceReadLimit(t *testing.T) {
+	ctx := context.Background()
+
+	// Every page has more entries after it, so reading them all would never finish.
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.ListEntriesResponseBody{
+			CatalogEntries: []client.CatalogEntryV2{{Id: "01", CatalogTypeId: "type", Name: "Payments"}},
+			PaginationMeta: client.PaginationMetaResult{After: lo.ToPtr("01"), PageSize: 1},
+		})
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataSource := &IncidentCatalogEntriesDataSource{client: apiClient}
+
+	var schemaResp datasource.SchemaResponse
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range configType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["catalog_type_id"] = tftypes.NewValue(tftypes.String, "type")
+	attributes["limit"] = tftypes.NewValue(tftypes.Number, 1)
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	dataSource.Read(ctx, datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, attributes)},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if len(requests) != 1 || requests[0] != "catalog_type_id=type&page_size=1" {
+		t.Errorf("expected a single request for one entry, got %v", requests)
+	}
+
+	var data IncidentCatalogEntriesDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if len(data.CatalogEntries) != 1 || data.CatalogEntries[0].Name.ValueString() != "Payments" {
+		t.Errorf("expected only the Payments entry, got %v", data.CatalogEntries)
+	}
+	if !data.TotalCount.IsNull() {
+		t.Errorf("expected an unknown total count, got %v", data.TotalCount)
+	}
+}
+
+func TestCatalogEntryHasAttributeValue(t *testing.T) {
+	literal := func(value string) client.CatalogEntryEngineParamBindingValueV2 {
+		return client.CatalogEntryEngineParamBindingValueV2{Literal: lo.ToPtr(value)}
+	}
+	entry := client.CatalogEntryV2{
+		AttributeValues: map[string]client.CatalogEntryEngineParamBindingV2{
+			"tier": {Value: lo.ToPtr(literal("1"))},
+			"teams": {ArrayValue: &[]client.CatalogEntryEngineParamBindingValueV2{
+				literal("payments"), literal("platform"),
+			}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		attributeID string
+		value       string
+		expected    bool
+	}{
+		{"scalar match", "tier", "1", true},
+		{"scalar mismatch", "tier", "2", false},
+		{"array match", "teams", "platform", true},
+		{"array mismatch", "teams", "search", false},
+		{"no value for attribute", "owner", "payments", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := catalogEntryHasAttributeValue(entry, tc.attributeID, tc.value); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccIncidentCatalogEntriesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentCatalogEntryResourceConfig("One", "This is the first entry", []string{}) + `
+data "incident_catalog_entries" "all" {
+  catalog_type_id = incident_catalog_entry.example.catalog_type_id
+}
+
+data "incident_catalog_entries" "matching" {
+  catalog_type_id = incident_catalog_entry.example.catalog_type_id
+  attribute_id    = incident_catalog_type_attribute.example_description.id
+  attribute_value = "This is the first entry"
+}
+
+data "incident_catalog_entries" "not_matching" {
+  catalog_type_id = incident_catalog_entry.example.catalog_type_id
+  attribute_id    = incident_catalog_type_attribute.example_description.id
+  attribute_value = "This is another entry"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_entries.all", "catalog_entries.#", "1"),
+					resource.TestCheckNoResourceAttr(
+						"data.incident_catalog_entries.all", "total_count"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_catalog_entries.matching", "catalog_entries.0.id",
+						"incident_catalog_entry.example", "id"),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_entries.matching", "total_count", "1"),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_entries.not_matching", "catalog_entries.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,10 +29,12 @@ import (
 var (
 	_ resource.Resource                = &IncidentCatalogEntriesResource{}
 	_ resource.ResourceWithImportState = &IncidentCatalogEntriesResource{}
+	_ resource.ResourceWithModifyPlan  = &IncidentCatalogEntriesResource{}
 )
 
 type IncidentCatalogEntriesResource struct {
-	client *client.ClientWithResponses
+	client       *client.ClientWithResponses
+	catalogTypes *catalogTypesCache
 }
 
 type IncidentCatalogEntriesResourceModel struct {
@@ -85,6 +90,13 @@ We call this the 'external ID' and it might be something like:
 
 This external ID is what we use as a map key for the entries attribute, and how we map
 changes to one entry to an update to that same entry when the upstream changes.
+
+## Validation
+
+Before changing any entries, attribute values are checked against the schema of the
+catalog type. Values for attributes that don't exist, or that use ` + "`value`" + ` for an array
+attribute (or ` + "`array_value`" + ` for a non-array one), are reported against the entry they
+belong to, so mistakes in a large JSON or CSV file are easy to find.
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -163,7 +175,8 @@ func (r *IncidentCatalogEntriesResource) Configure(ctx context.Context, req reso
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyCatalog)
+	r.catalogTypes = client.CatalogTypes
 }
 
 func (r *IncidentCatalogEntriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -173,6 +186,11 @@ func (r *IncidentCatalogEntriesResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	resp.Diagnostics.Append(r.validate(ctx, data.ID.ValueString(), data.attributeValues(), false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	catalogType, entries, err := r.reconcile(ctx, data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
@@ -207,6 +225,11 @@ func (r *IncidentCatalogEntriesResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	resp.Diagnostics.Append(r.validate(ctx, data.ID.ValueString(), data.attributeValues(), false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	catalogType, entries, err := r.reconcile(ctx, data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
@@ -305,15 +328,10 @@ func (r *IncidentCatalogEntriesResource) buildModel(catalogType client.CatalogTy
 			values[attributeID] = value
 		}
 
-		aliases := []attr.Value{}
-		for _, alias := range entry.Aliases {
-			aliases = append(aliases, types.StringValue(alias))
-		}
-
 		modelEntries[*entry.ExternalId] = CatalogEntryModel{
 			ID:              types.StringValue(entry.Id),
 			Name:            types.StringValue(entry.Name),
-			Aliases:         types.ListValueMust(types.StringType, aliases),
+			Aliases:         stringListValue(entry.Aliases),
 			Rank:            types.Int64Value(int64(entry.Rank)),
 			AttributeValues: values,
 			externalID:      *entry.ExternalId,
@@ -391,19 +409,160 @@ func (m IncidentCatalogEntriesResourceModel) buildPayloads(ctx context.Context)
 	return payloads
 }
 
+// ModifyPlan validates attribute values at plan time, so typos in attribute IDs are
+// caught before anything is applied. Values bound to attributes that don't exist yet,
+// such as those created in the same plan, are only known at apply time, so they're
+// skipped here and checked again by Create and Update. We only check that attributes
+// exist, as whether they're arrays may change in the same plan.
+func (r *IncidentCatalogEntriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return // nothing to check when destroying, or before the provider is configured
+	}
+
+	var (
+		catalogTypeID types.String
+		entries       types.Map
+	)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &catalogTypeID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("entries"), &entries)...)
+	if resp.Diagnostics.HasError() || catalogTypeID.IsUnknown() || entries.IsUnknown() {
+		return
+	}
+
+	attributeValues := map[string]map[string]CatalogEntryAttributeBindingModel{}
+	for externalID := range entries.Elements() {
+		var bindings types.Map
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("entries").AtMapKey(externalID).AtName("attribute_values"), &bindings)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if bindings.IsUnknown() || bindings.IsNull() {
+			continue
+		}
+
+		values := map[string]CatalogEntryAttributeBindingModel{}
+		resp.Diagnostics.Append(bindings.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		attributeValues[externalID] = values
+	}
+
+	resp.Diagnostics.Append(r.validate(ctx, catalogTypeID.ValueString(), attributeValues, true)...)
+}
+
+// attributeValues returns the attribute values of each entry, keyed by external ID.
+func (m *IncidentCatalogEntriesResourceModel) attributeValues() map[string]map[string]CatalogEntryAttributeBindingModel {
+	return lo.MapValues(m.Entries, func(entry CatalogEntryModel, _ string) map[string]CatalogEntryAttributeBindingModel {
+		return entry.AttributeValues
+	})
+}
+
+// validate checks the attribute values of every entry against the schema of the
+// catalog type, so we fail before making any changes rather than part way through.
+// When planning, the schema may be about to change, so we only check attributes exist.
+// The catalog type comes from the shared cache, which is invalidated by any change to a
+// catalog type or its attributes earlier in the apply.
+func (r *IncidentCatalogEntriesResource) validate(ctx context.Context, catalogTypeID string, attributeValues map[string]map[string]CatalogEntryAttributeBindingModel, planning bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	catalogType, err := r.catalogTypes.get(ctx, r.client, catalogTypeID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
+		return diags
+	}
+
+	if catalogTypeHasExternalEntries(*catalogType) {
+		diags.AddAttributeError(path.Root("id"), "Catalog type entries are managed externally",
+			fmt.Sprintf("The catalog type %s has externally_managed_entries set, so its entries are managed at %s rather than by Terraform.",
+				catalogType.Name, lo.FromPtr(catalogType.SourceRepoUrl)))
+		return diags
+	}
+
+	attributes := lo.KeyBy(catalogType.Schema.Attributes, func(attribute client.CatalogTypeAttributeV2) string {
+		return attribute.Id
+	})
+
+	externalIDs := lo.Keys(attributeValues)
+	sort.Strings(externalIDs)
+	for _, externalID := range externalIDs {
+		for attributeID, binding := range attributeValues[externalID] {
+			attributePath := path.Root("entries").AtMapKey(externalID).AtName("attribute_values").AtMapKey(attributeID)
+			if planning {
+				diags.Append(validateCatalogAttributeID(attributes, attributeID, attributePath)...)
+			} else {
+				diags.Append(validateCatalogAttributeBinding(attributes, attributeID, binding.Value, binding.ArrayValue, attributePath)...)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateCatalogAttributeBinding checks a value for a catalog attribute against the
+// attributes in the catalog type's schema, keyed by ID.
+func validateCatalogAttributeBinding(attributes map[string]client.CatalogTypeAttributeV2, attributeID string, value types.String, arrayValue types.List, attributePath path.Path) diag.Diagnostics {
+	diags := validateCatalogAttributeID(attributes, attributeID, attributePath)
+	if diags.HasError() {
+		return diags
+	}
+
+	attribute := attributes[attributeID]
+
+	if attribute.Array && !value.IsNull() && !value.IsUnknown() {
+		diags.AddAttributeError(attributePath, "Invalid catalog attribute value",
+			fmt.Sprintf("The %s attribute is an array, so must be set using array_value rather than value.", attribute.Name))
+	}
+	if !attribute.Array && !arrayValue.IsNull() && !arrayValue.IsUnknown() {
+		diags.AddAttributeError(attributePath, "Invalid catalog attribute value",
+			fmt.Sprintf("The %s attribute is not an array, so must be set using value rather than array_value.", attribute.Name))
+	}
+
+	return diags
+}
+
+// validateCatalogAttributeID checks an attribute with the given ID is in the catalog
+// type's schema, keyed by ID.
+func validateCatalogAttributeID(attributes map[string]client.CatalogTypeAttributeV2, attributeID string, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := attributes[attributeID]; !ok {
+		diags.AddAttributeError(attributePath, "Unknown catalog attribute",
+			fmt.Sprintf("The catalog type has no attribute with ID %s. Valid attributes are: %s.", attributeID, describeCatalogAttributes(attributes)))
+	}
+
+	return diags
+}
+
+// describeCatalogAttributes lists attributes as "Name (ID)", ordered by name, to help
+// find the right ID when a value is bound to the wrong one.
+func describeCatalogAttributes(attributes map[string]client.CatalogTypeAttributeV2) string {
+	descriptions := lo.MapToSlice(attributes, func(id string, attribute client.CatalogTypeAttributeV2) string {
+		return fmt.Sprintf("%s (%s)", attribute.Name, id)
+	})
+	sort.Strings(descriptions)
+
+	return strings.Join(descriptions, ", ")
+}
+
 func (r *IncidentCatalogEntriesResource) getEntries(ctx context.Context, catalogTypeID string) (catalogType *client.CatalogTypeV2, entries []client.CatalogEntryV2, err error) {
+	return listCatalogEntries(ctx, r.client, catalogTypeID)
+}
+
+// listCatalogEntries loads every entry of a catalog type, along with the type itself.
+func listCatalogEntries(ctx context.Context, apiClient *client.ClientWithResponses, catalogTypeID string) (catalogType *client.CatalogTypeV2, entries []client.CatalogEntryV2, err error) {
 	var (
 		after *string
 	)
 
 	for {
-		result, err := r.client.CatalogV2ListEntriesWithResponse(ctx, &client.CatalogV2ListEntriesParams{
+		result, err := apiClient.CatalogV2ListEntriesWithResponse(ctx, &client.CatalogV2ListEntriesParams{
 			CatalogTypeId: catalogTypeID,
 			PageSize:      lo.ToPtr(int64(250)),
 			After:         after,
 		})
 		if err == nil && result.StatusCode() >= 400 {
-			err = fmt.Errorf(string(result.Body))
+			err = clientError(result.StatusCode(), result.Body)
 		}
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "listing entries")
@@ -466,7 +625,7 @@ func (r *IncidentCatalogEntriesResource) reconcile(ctx context.Context, data *In
 			g.Go(func() error {
 				result, err := r.client.CatalogV2DestroyEntryWithResponse(ctx, entry.Id)
 				if err == nil && result.StatusCode() >= 400 {
-					err = fmt.Errorf(string(result.Body))
+					err = clientError(result.StatusCode(), result.Body)
 				}
 				if err != nil {
 					return errors.Wrap(err, "unable to destroy catalog entry, got error")
@@ -557,7 +716,7 @@ func (r *IncidentCatalogEntriesResource) reconcile(ctx context.Context, data *In
 						AttributeValues: payload.Payload.AttributeValues,
 					})
 					if err == nil && result.StatusCode() >= 400 {
-						err = fmt.Errorf(string(result.Body))
+						err = clientError(result.StatusCode(), result.Body)
 					}
 					if err != nil {
 						return errors.Wrap(err, fmt.Sprintf("unable to update catalog entry with id=%s, got error", entry.Id))
@@ -574,7 +733,7 @@ func (r *IncidentCatalogEntriesResource) reconcile(ctx context.Context, data *In
 						AttributeValues: payload.Payload.AttributeValues,
 					})
 					if err == nil && result.StatusCode() >= 400 {
-						err = fmt.Errorf(string(result.Body))
+						err = clientError(result.StatusCode(), result.Body)
 					}
 					if err != nil {
 						return errors.Wrap(err, fmt.Sprintf("unable to create catalog entry with external_id=%s, got error", *payload.Payload.ExternalId))
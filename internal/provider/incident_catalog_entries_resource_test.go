@@ -2,14 +2,98 @@ package provider
 
 import (
 	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 	"text/template"
 
 	"github.com/Masterminds/sprig"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
 )
 
+func TestValidateCatalogAttributeBinding(t *testing.T) {
+	attributes := map[string]client.CatalogTypeAttributeV2{
+		"owner": {Id: "owner", Name: "Owner"},
+		"tags":  {Id: "tags", Name: "Tags", Array: true},
+	}
+	tags := types.ListValueMust(types.StringType, stringAttrValues([]string{"java"}))
+
+	for _, tc := range []struct {
+		name        string
+		attributeID string
+		value       types.String
+		arrayValue  types.List
+		errors      int
+	}{
+		{"value", "owner", types.StringValue("artist-relations"), types.ListNull(types.StringType), 0},
+		{"array value", "tags", types.StringNull(), tags, 0},
+		{"unknown attribute", "team", types.StringValue("artist-relations"), types.ListNull(types.StringType), 1},
+		{"value for array", "tags", types.StringValue("java"), types.ListNull(types.StringType), 1},
+		{"array value for non-array", "owner", types.StringNull(), tags, 1},
+		{"unknown value", "tags", types.StringUnknown(), tags, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateCatalogAttributeBinding(attributes, tc.attributeID, tc.value, tc.arrayValue, path.Root("attribute_values").AtMapKey(tc.attributeID))
+			if got := diags.ErrorsCount(); got != tc.errors {
+				t.Errorf("expected %d errors, got %d: %v", tc.errors, got, diags)
+			}
+		})
+	}
+}
+
+func TestValidateCatalogAttributeID(t *testing.T) {
+	attributes := map[string]client.CatalogTypeAttributeV2{
+		"owner": {Id: "owner", Name: "Owner"},
+	}
+
+	if diags := validateCatalogAttributeID(attributes, "owner", path.Root("attribute_values").AtMapKey("owner")); diags.HasError() {
+		t.Errorf("expected no errors for a known attribute, got: %v", diags)
+	}
+	if diags := validateCatalogAttributeID(attributes, "team", path.Root("attribute_values").AtMapKey("team")); diags.ErrorsCount() != 1 {
+		t.Errorf("expected an error for an unknown attribute, got: %v", diags)
+	}
+}
+
+func TestIncidentCatalogEntriesResourceValidate(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"catalog_types": [{"id": "01GW2G3V0S59R238FAHPDS1R66", "name": "Service", "schema": {"attributes": [{"id": "owner", "name": "Owner"}]}}]}`))
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &IncidentCatalogEntriesResource{client: apiClient, catalogTypes: &catalogTypesCache{}}
+
+	attributeValues := map[string]map[string]CatalogEntryAttributeBindingModel{
+		"payments": {
+			"owner": {Value: types.StringValue("Payments"), ArrayValue: types.ListNull(types.StringType)},
+			"tier":  {Value: types.StringValue("1"), ArrayValue: types.ListNull(types.StringType)},
+		},
+	}
+
+	// Planning and applying share the catalog type, rather than loading it each time.
+	for _, planning := range []bool{true, false} {
+		diags := r.validate(context.Background(), "01GW2G3V0S59R238FAHPDS1R66", attributeValues, planning)
+		if len(diags) != 1 || diags[0].Summary() != "Unknown catalog attribute" {
+			t.Errorf("expected only an unknown tier attribute, got %v", diags)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+}
+
 func TestAccIncidentCatalogEntriesResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -65,6 +149,20 @@ func TestAccIncidentCatalogEntriesResource(t *testing.T) {
 						"incident_catalog_entries.example", "entries.two.name", "Three"),
 				),
 			},
+			// Bind a value to an attribute that doesn't exist, which fails at plan time
+			{
+				Config: testAccIncidentCatalogEntriesResourceConfig([]catalogEntryElement{
+					{
+						Name:               "One",
+						ExternalID:         "one",
+						Description:        "This is the first entry",
+						ArrayValue:         "null",
+						UnknownAttributeID: "01GW2G3V0S59R238FAHPDS1R66",
+					},
+				}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unknown catalog attribute"),
+			},
 		},
 	})
 }
@@ -106,6 +204,11 @@ resource "incident_catalog_entries" "example" {
         (incident_catalog_type_attribute.example_array.id) = {
           array_value = {{ .ArrayValue }}
         }
+        {{ if .UnknownAttributeID }}
+        {{ quote .UnknownAttributeID }} = {
+          value = "Bound to an attribute that doesn't exist"
+        }
+        {{ end }}
       }
     },
   {{ end }}
@@ -119,6 +222,9 @@ type catalogEntryElement struct {
 	Aliases     []string
 	Description string
 	ArrayValue  string
+
+	// UnknownAttributeID binds a value to an attribute ID that isn't in the schema.
+	UnknownAttributeID string
 }
 
 func testAccIncidentCatalogEntriesResourceConfig(entries []catalogEntryElement) string {
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ resource.Resource                = &IncidentCatalogEntryAttributeValueResource{}
+	_ resource.ResourceWithImportState = &IncidentCatalogEntryAttributeValueResource{}
+)
+
+type IncidentCatalogEntryAttributeValueResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentCatalogEntryAttributeValueResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	CatalogEntryID types.String `tfsdk:"catalog_entry_id"`
+	Attribute      types.String `tfsdk:"attribute"`
+	Value          types.String `tfsdk:"value"`
+	ArrayValue     types.List   `tfsdk:"array_value"`
+}
+
+func NewIncidentCatalogEntryAttributeValueResource() resource.Resource {
+	return &IncidentCatalogEntryAttributeValueResource{}
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entry_attribute_value"
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This resource manages the value of a single attribute of a catalog entry, leaving the
+entry's other attributes alone. Use it when a different team owns one attribute of an
+entry, such as a security team owning the tier of each service.
+
+Changing a value reads the whole entry and writes it back with that value updated.
+Changes within one apply are made one at a time, but the API can't detect concurrent
+writes, so applies from separate Terraform states that change the same entry at the
+same time can revert each other's changes. If an entry's attributes are managed from
+several states, don't run their applies concurrently.
+
+If the entry itself is managed by ` + "`incident_catalog_entry`" + `, set ` + "`managed_attributes`" + ` on
+that resource so it doesn't remove values managed by this resource.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this attribute value, in the form `catalog_entry_id:attribute`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"catalog_entry_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the catalog entry this value belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attribute": schema.StringAttribute{
+				MarkdownDescription: "The ID of this attribute, usually loaded from the incident_catalog_type_attribute resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of this attribute, in a format suitable for this attribute type.",
+				Optional:            true,
+			},
+			"array_value": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The value of this element of the array, in a format suitable for this attribute type.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.ClientFor(apiKeyFamilyCatalog)
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentCatalogEntryAttributeValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, diags := r.setValue(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = r.buildModel(*entry, data.Attribute.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentCatalogEntryAttributeValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CatalogV2ShowEntryWithResponse(ctx, data.CatalogEntryID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entry, got error: %s", err))
+		return
+	}
+
+	if result.StatusCode() == 404 {
+		resp.Diagnostics.AddWarning("Not Found", fmt.Sprintf("Unable to read catalog entry, got status code: %d", result.StatusCode()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entry, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.CatalogEntry, data.Attribute.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentCatalogEntryAttributeValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entry, diags := r.setValue(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = r.buildModel(*entry, data.Attribute.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentCatalogEntryAttributeValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.setValue(ctx, data, true)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	entryID, attributeID, found := strings.Cut(req.ID, ":")
+	if !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: catalog_entry_id:attribute, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("catalog_entry_id"), entryID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attribute"), attributeID)...)
+}
+
+// setValue writes our attribute value into the entry, or removes it if remove is
+// true, preserving all the entry's other fields and attribute values. The lock only
+// serialises writes from this process: writes from other processes between our
+// read and update are lost.
+func (r *IncidentCatalogEntryAttributeValueResource) setValue(ctx context.Context, data *IncidentCatalogEntryAttributeValueResourceModel, remove bool) (*client.CatalogEntryV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	unlock := lockObject(data.CatalogEntryID.ValueString())
+	defer unlock()
+
+	existing, err := r.client.CatalogV2ShowEntryWithResponse(ctx, data.CatalogEntryID.ValueString())
+	if err == nil && existing.StatusCode() >= 400 {
+		if remove && existing.StatusCode() == 404 {
+			return nil, diags // the entry has gone, and our value with it
+		}
+		err = clientError(existing.StatusCode(), existing.Body)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read catalog entry, got error: %s", err))
+		return nil, diags
+	}
+
+	entry := existing.JSON200.CatalogEntry
+	values := catalogEntryAttributeValuePayloads(entry.AttributeValues)
+	if remove {
+		delete(values, data.Attribute.ValueString())
+	} else {
+		values[data.Attribute.ValueString()] = CatalogEntryAttributeValue{
+			Value:      data.Value,
+			ArrayValue: data.ArrayValue,
+		}.buildPayload()
+	}
+
+	result, err := r.client.CatalogV2UpdateEntryWithResponse(ctx, entry.Id, client.UpdateEntryRequestBody{
+		Name:            entry.Name,
+		Rank:            lo.ToPtr(entry.Rank),
+		Aliases:         lo.ToPtr(entry.Aliases),
+		ExternalId:      entry.ExternalId,
+		AttributeValues: values,
+	})
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update catalog entry attribute value, got error: %s", err))
+		return nil, diags
+	}
+
+	return &result.JSON200.CatalogEntry, diags
+}
+
+func (r *IncidentCatalogEntryAttributeValueResource) buildModel(entry client.CatalogEntryV2, attributeID string) *IncidentCatalogEntryAttributeValueResourceModel {
+	model := &IncidentCatalogEntryAttributeValueResourceModel{
+		ID:             types.StringValue(entry.Id + ":" + attributeID),
+		CatalogEntryID: types.StringValue(entry.Id),
+		Attribute:      types.StringValue(attributeID),
+		Value:          types.StringNull(),
+		ArrayValue:     types.ListNull(types.StringType),
+	}
+	if binding, ok := entry.AttributeValues[attributeID]; ok {
+		value := buildCatalogEntryAttributeValue(attributeID, binding)
+		model.Value = value.Value
+		model.ArrayValue = value.ArrayValue
+	}
+
+	return model
+}
+
+// catalogEntryAttributeValuePayloads converts the attribute values of an entry we've
+// read from the API into the payload needed to write them back unchanged.
+func catalogEntryAttributeValuePayloads(bindings map[string]client.CatalogEntryEngineParamBindingV2) map[string]client.EngineParamBindingPayloadV2 {
+	values := map[string]client.EngineParamBindingPayloadV2{}
+	for attributeID, binding := range bindings {
+		payload := client.EngineParamBindingPayloadV2{}
+		if binding.Value != nil {
+			payload.Value = &client.EngineParamBindingValuePayloadV2{
+				Literal: binding.Value.Literal,
+			}
+		}
+		if binding.ArrayValue != nil {
+			payload.ArrayValue = lo.ToPtr(lo.Map(*binding.ArrayValue, func(value client.CatalogEntryEngineParamBindingValueV2, _ int) client.EngineParamBindingValuePayloadV2 {
+				return client.EngineParamBindingValuePayloadV2{
+					Literal: value.Literal,
+				}
+			}))
+		}
+
+		values[attributeID] = payload
+	}
+
+	return values
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentCatalogEntryAttributeValueResource(t *testing.T) {
+	id := uuid.NewString()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentCatalogEntryAttributeValueResourceConfig(id, "Takes payments", "Security"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_entry_attribute_value.example", "value", "Security"),
+					resource.TestCheckResourceAttr(
+						"incident_catalog_entry.example", "attribute_values.#", "1"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_catalog_entry_attribute_value.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the attribute value
+			{
+				Config: testAccIncidentCatalogEntryAttributeValueResourceConfig(id, "Takes payments", "Platform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_entry_attribute_value.example", "value", "Platform"),
+				),
+			},
+			// Update the entry, which should leave the attribute value alone
+			{
+				Config: testAccIncidentCatalogEntryAttributeValueResourceConfig(id, "Takes and refunds payments", "Platform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_entry_attribute_value.example", "value", "Platform"),
+				),
+			},
+		},
+	})
+}
+
+var catalogEntryAttributeValueTemplate = template.Must(template.New("incident_catalog_entry_attribute_value").Funcs(sprig.TxtFuncMap()).Parse(`
+resource "incident_catalog_type" "example" {
+  name        = "Catalog Entry Attribute Value Acceptance Test ({{ .ID }})"
+  description = "Used in terraform acceptance tests for incident_catalog_entry_attribute_value"
+}
+
+resource "incident_catalog_type_attribute" "example_description" {
+  catalog_type_id = incident_catalog_type.example.id
+
+  name = "Description"
+  type = "Text"
+}
+
+resource "incident_catalog_type_attribute" "example_owner" {
+  catalog_type_id = incident_catalog_type.example.id
+
+  name = "Owner"
+  type = "String"
+}
+
+resource "incident_catalog_entry" "example" {
+  catalog_type_id = incident_catalog_type.example.id
+
+  name = "Payments"
+
+  managed_attributes = [incident_catalog_type_attribute.example_description.id]
+  attribute_values = [
+    {
+      attribute = incident_catalog_type_attribute.example_description.id,
+      value     = {{ quote .Description }}
+    }
+  ]
+}
+
+resource "incident_catalog_entry_attribute_value" "example" {
+  catalog_entry_id = incident_catalog_entry.example.id
+  attribute        = incident_catalog_type_attribute.example_owner.id
+  value            = {{ quote .Owner }}
+}
+`))
+
+func testAccIncidentCatalogEntryAttributeValueResourceConfig(id, description, owner string) string {
+	var buf bytes.Buffer
+	if err := catalogEntryAttributeValueTemplate.Execute(&buf, struct {
+		ID          string
+		Description string
+		Owner       string
+	}{
+		ID:          id,
+		Description: description,
+		Owner:       owner,
+	}); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentCatalogEntryDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentCatalogEntryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentCatalogEntryDataSource{}
+)
+
+func NewIncidentCatalogEntryDataSource() datasource.DataSource {
+	return &IncidentCatalogEntryDataSource{}
+}
+
+type IncidentCatalogEntryDataSource struct {
+	client    *client.ClientWithResponses
+	dashboard *DashboardURL
+}
+
+type IncidentCatalogEntryDataSourceModel struct {
+	ID              types.String                 `tfsdk:"id"`
+	CatalogTypeID   types.String                 `tfsdk:"catalog_type_id"`
+	ExternalID      types.String                 `tfsdk:"external_id"`
+	Alias           types.String                 `tfsdk:"alias"`
+	Name            types.String                 `tfsdk:"name"`
+	Aliases         types.List                   `tfsdk:"aliases"`
+	Rank            types.Int64                  `tfsdk:"rank"`
+	AttributeValues []CatalogEntryAttributeValue `tfsdk:"attribute_values"`
+	AppURL          types.String                 `tfsdk:"app_url"`
+}
+
+func (i *IncidentCatalogEntryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entry"
+}
+
+func (i *IncidentCatalogEntryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides information about a catalog entry, looked up within a catalog type by exactly one of `external_id`, `alias` or `name`. Use it to reference entries by something stable, such as the ID of the service in your service catalog, rather than copying entry IDs from the dashboard.",
+		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this catalog entry in the incident.io dashboard.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "id"),
+				Computed:            true,
+			},
+			"catalog_type_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "catalog_type_id"),
+				Required:            true,
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "external_id"),
+				Optional:            true,
+				Computed:            true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "One of the entry's aliases, to look the entry up by.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "name"),
+				Optional:            true,
+				Computed:            true,
+			},
+			"aliases": schema.ListAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "aliases"),
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"rank": schema.Int64Attribute{
+				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "rank"),
+				Computed:            true,
+			},
+			"attribute_values": schema.ListNestedAttribute{
+				MarkdownDescription: "The entry's attribute values, ordered by attribute ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							MarkdownDescription: "The ID of this attribute.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value of this attribute, if it's a scalar.",
+							Computed:            true,
+						},
+						"array_value": schema.ListAttribute{
+							MarkdownDescription: "The values of this attribute, if it's an array.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (i *IncidentCatalogEntryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IncidentCatalogEntryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := lo.Filter([]types.String{data.ExternalID, data.Alias, data.Name}, func(value types.String, _ int) bool {
+		return !value.IsNull()
+	})
+	if len(set) != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid catalog entry lookup",
+			"Exactly one of external_id, alias or name must be set.",
+		)
+	}
+}
+
+func (i *IncidentCatalogEntryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyCatalog)
+	i.dashboard = client.DashboardURL
+}
+
+func (i *IncidentCatalogEntryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentCatalogEntryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, entries, err := listCatalogEntries(ctx, i.client, data.CatalogTypeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list catalog entries, got error: %s", err))
+		return
+	}
+
+	entry, err := findCatalogEntry(entries, data.ExternalID, data.Alias, data.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find catalog entry, got error: %s", err))
+		return
+	}
+
+	model := new(IncidentCatalogEntryResource).buildModel(*entry, nil)
+	modelResp := IncidentCatalogEntryDataSourceModel{
+		ID:              model.ID,
+		CatalogTypeID:   model.CatalogTypeID,
+		ExternalID:      types.StringPointerValue(entry.ExternalId),
+		Alias:           data.Alias,
+		Name:            model.Name,
+		Aliases:         model.Aliases,
+		Rank:            model.Rank,
+		AttributeValues: model.AttributeValues,
+		AppURL:          i.dashboard.Build(ctx, "catalog", entry.CatalogTypeId, entry.Id),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
+
+// findCatalogEntry finds the single entry matching whichever of external ID, alias or
+// name is set. Names aren't unique, so we error rather than guess if several match.
+func findCatalogEntry(entries []client.CatalogEntryV2, externalID, alias, name types.String) (*client.CatalogEntryV2, error) {
+	var (
+		matches     []client.CatalogEntryV2
+		description string
+	)
+	switch {
+	case !externalID.IsNull():
+		description = fmt.Sprintf("external_id %q", externalID.ValueString())
+		matches = lo.Filter(entries, func(entry client.CatalogEntryV2, _ int) bool {
+			return lo.FromPtr(entry.ExternalId) == externalID.ValueString()
+		})
+	case !alias.IsNull():
+		description = fmt.Sprintf("alias %q", alias.ValueString())
+		matches = lo.Filter(entries, func(entry client.CatalogEntryV2, _ int) bool {
+			return lo.Contains(entry.Aliases, alias.ValueString())
+		})
+	case !name.IsNull():
+		description = fmt.Sprintf("name %q", name.ValueString())
+		matches = lo.Filter(entries, func(entry client.CatalogEntryV2, _ int) bool {
+			return entry.Name == name.ValueString()
+		})
+	default:
+		return nil, fmt.Errorf("one of external_id, alias or name must be set")
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no catalog entry with %s", description)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d catalog entries with %s, use external_id instead", len(matches), description)
+	}
+}
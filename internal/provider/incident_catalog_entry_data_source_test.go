@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestFindCatalogEntry(t *testing.T) {
+	entries := []client.CatalogEntryV2{
+		{Id: "01GW2G3V0S59R238FAHPDS1R66", Name: "Payments", Aliases: []string{"payments-api"}, ExternalId: lo.ToPtr("svc-1")},
+		{Id: "01GW2G3V0S59R238FAHPDS1R67", Name: "Search", ExternalId: lo.ToPtr("svc-2")},
+		{Id: "01GW2G3V0S59R238FAHPDS1R68", Name: "Search"},
+	}
+
+	for _, tc := range []struct {
+		name                         string
+		externalID, alias, entryName types.String
+		expectedID                   string
+		expectedErr                  string
+	}{
+		{
+			name:       "by external ID",
+			externalID: types.StringValue("svc-2"),
+			alias:      types.StringNull(),
+			entryName:  types.StringNull(),
+			expectedID: "01GW2G3V0S59R238FAHPDS1R67",
+		},
+		{
+			name:       "by alias",
+			externalID: types.StringNull(),
+			alias:      types.StringValue("payments-api"),
+			entryName:  types.StringNull(),
+			expectedID: "01GW2G3V0S59R238FAHPDS1R66",
+		},
+		{
+			name:       "by name",
+			externalID: types.StringNull(),
+			alias:      types.StringNull(),
+			entryName:  types.StringValue("Payments"),
+			expectedID: "01GW2G3V0S59R238FAHPDS1R66",
+		},
+		{
+			name:        "by ambiguous name",
+			externalID:  types.StringNull(),
+			alias:       types.StringNull(),
+			entryName:   types.StringValue("Search"),
+			expectedErr: `found 2 catalog entries with name "Search", use external_id instead`,
+		},
+		{
+			name:        "not found",
+			externalID:  types.StringValue("svc-3"),
+			alias:       types.StringNull(),
+			entryName:   types.StringNull(),
+			expectedErr: `no catalog entry with external_id "svc-3"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, err := findCatalogEntry(entries, tc.externalID, tc.alias, tc.entryName)
+			if tc.expectedErr != "" {
+				if err == nil || err.Error() != tc.expectedErr {
+					t.Fatalf("expected error %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if entry.Id != tc.expectedID {
+				t.Errorf("expected entry %s, got %s", tc.expectedID, entry.Id)
+			}
+		})
+	}
+}
+
+func TestAccIncidentCatalogEntryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentCatalogEntryResourceConfig("One", "This is the first entry", []string{"one"}) + `
+data "incident_catalog_entry" "by_name" {
+  catalog_type_id = incident_catalog_entry.example.catalog_type_id
+  name            = incident_catalog_entry.example.name
+}
+
+data "incident_catalog_entry" "by_alias" {
+  catalog_type_id = incident_catalog_entry.example.catalog_type_id
+  alias           = incident_catalog_entry.example.aliases[0]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.incident_catalog_entry.by_name", "id",
+						"incident_catalog_entry.example", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_catalog_entry.by_alias", "id",
+						"incident_catalog_entry.example", "id"),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_entry.by_alias", "attribute_values.0.value", "This is the first entry"),
+				),
+			},
+			{
+				Config: `
+data "incident_catalog_entry" "ambiguous" {
+  catalog_type_id = "01GW2G3V0S59R238FAHPDS1R66"
+  name            = "One"
+  alias           = "one"
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of external_id, alias or name must be set"),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,49 +23,54 @@ import (
 var (
 	_ resource.Resource                = &IncidentCatalogEntryResource{}
 	_ resource.ResourceWithImportState = &IncidentCatalogEntryResource{}
+	_ resource.ResourceWithModifyPlan  = &IncidentCatalogEntryResource{}
 )
 
 type IncidentCatalogEntryResource struct {
-	client *client.ClientWithResponses
+	client       *client.ClientWithResponses
+	dashboard    *DashboardURL
+	catalogTypes *catalogTypesCache
 }
 
 type IncidentCatalogEntryResourceModel struct {
-	ID              types.String                 `tfsdk:"id"`
-	CatalogTypeID   types.String                 `tfsdk:"catalog_type_id"`
-	Name            types.String                 `tfsdk:"name"`
-	Aliases         types.List                   `tfsdk:"aliases"`
-	Rank            types.Int64                  `tfsdk:"rank"`
-	AttributeValues []CatalogEntryAttributeValue `tfsdk:"attribute_values"`
+	ID                types.String                 `tfsdk:"id"`
+	CatalogTypeID     types.String                 `tfsdk:"catalog_type_id"`
+	Name              types.String                 `tfsdk:"name"`
+	Aliases           types.List                   `tfsdk:"aliases"`
+	Rank              types.Int64                  `tfsdk:"rank"`
+	AttributeValues   []CatalogEntryAttributeValue `tfsdk:"attribute_values"`
+	ManagedAttributes types.Set                    `tfsdk:"managed_attributes"`
+	AppURL            types.String                 `tfsdk:"app_url"`
 }
 
 func (m IncidentCatalogEntryResourceModel) buildAttributeValues() map[string]client.EngineParamBindingPayloadV2 {
 	values := map[string]client.EngineParamBindingPayloadV2{}
 	for _, attributeValue := range m.AttributeValues {
-		payload := client.EngineParamBindingPayloadV2{}
-		if !attributeValue.Value.IsNull() {
-			payload.Value = &client.EngineParamBindingValuePayloadV2{
-				Literal: lo.ToPtr(attributeValue.Value.ValueString()),
-			}
-		}
-		if !attributeValue.ArrayValue.IsNull() {
-			arrayValue := []client.EngineParamBindingValuePayloadV2{}
-			for _, element := range attributeValue.ArrayValue.Elements() {
-				elementString, ok := element.(types.String)
-				if !ok {
-					panic(fmt.Sprintf("element should have been types.String but was %T", element))
-				}
-				arrayValue = append(arrayValue, client.EngineParamBindingValuePayloadV2{
-					Literal: lo.ToPtr(elementString.ValueString()),
-				})
-			}
+		values[attributeValue.Attribute.ValueString()] = attributeValue.buildPayload()
+	}
 
-			payload.ArrayValue = &arrayValue
-		}
+	return values
+}
 
-		values[attributeValue.Attribute.ValueString()] = payload
+// checkManagedAttributes ensures we only set values for attributes we manage, as we'd
+// otherwise ignore them when reading the entry back.
+func (m IncidentCatalogEntryResourceModel) checkManagedAttributes(managed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if managed == nil {
+		return diags
 	}
 
-	return values
+	for _, attributeValue := range m.AttributeValues {
+		if !lo.Contains(managed, attributeValue.Attribute.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("attribute_values"),
+				"Unmanaged attribute value",
+				fmt.Sprintf("Attribute %s has a value but is not in managed_attributes.", attributeValue.Attribute.ValueString()),
+			)
+		}
+	}
+
+	return diags
 }
 
 type CatalogEntryAttributeValue struct {
@@ -73,6 +79,59 @@ type CatalogEntryAttributeValue struct {
 	ArrayValue types.List   `tfsdk:"array_value"`
 }
 
+func (v CatalogEntryAttributeValue) buildPayload() client.EngineParamBindingPayloadV2 {
+	payload := client.EngineParamBindingPayloadV2{}
+	if !v.Value.IsNull() {
+		payload.Value = &client.EngineParamBindingValuePayloadV2{
+			Literal: lo.ToPtr(v.Value.ValueString()),
+		}
+	}
+	if !v.ArrayValue.IsNull() {
+		arrayValue := []client.EngineParamBindingValuePayloadV2{}
+		for _, element := range v.ArrayValue.Elements() {
+			elementString, ok := element.(types.String)
+			if !ok {
+				panic(fmt.Sprintf("element should have been types.String but was %T", element))
+			}
+			arrayValue = append(arrayValue, client.EngineParamBindingValuePayloadV2{
+				Literal: lo.ToPtr(elementString.ValueString()),
+			})
+		}
+
+		payload.ArrayValue = &arrayValue
+	}
+
+	return payload
+}
+
+func buildCatalogEntryAttributeValue(attributeID string, binding client.CatalogEntryEngineParamBindingV2) CatalogEntryAttributeValue {
+	value := CatalogEntryAttributeValue{
+		Attribute:  types.StringValue(attributeID),
+		ArrayValue: types.ListNull(types.StringType),
+	}
+	// The API can behave weirdly in the case of empty arrays and omit the field entirely.
+	// This is painful for us as terraform will see the omission as a diff against the
+	// state, so we paper over the issue by instantiating an empty array value if we think
+	// we're seeing the weirdness.
+	if binding.Value == nil && binding.ArrayValue == nil {
+		binding.ArrayValue = lo.ToPtr([]client.CatalogEntryEngineParamBindingValueV2{})
+	}
+
+	if binding.Value != nil {
+		value.Value = types.StringValue(*binding.Value.Literal)
+	}
+	if binding.ArrayValue != nil {
+		elements := []attr.Value{}
+		for _, value := range *binding.ArrayValue {
+			elements = append(elements, types.StringValue(*value.Literal))
+		}
+
+		value.ArrayValue = types.ListValueMust(types.StringType, elements)
+	}
+
+	return value
+}
+
 func NewIncidentCatalogEntryResource() resource.Resource {
 	return &IncidentCatalogEntryResource{}
 }
@@ -92,6 +151,13 @@ If you're working with a large number of entries (>100) or want to be authoritat
 (remove anything Terraform does not manage) then prefer ` + "`incident_catalog_entries`" + `.
 		`,
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this catalog entry in the incident.io dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: apischema.Docstring("CatalogEntryV2ResponseBody", "id"),
@@ -124,6 +190,11 @@ If you're working with a large number of entries (>100) or want to be authoritat
 				Optional:            true,
 				Computed:            true,
 			},
+			"managed_attributes": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the attributes whose values this resource manages. If set, values of any other attributes are left untouched, so they can be managed elsewhere, such as by `incident_catalog_entry_attribute_value`. If not set, this resource manages the values of all attributes.",
+				Optional:            true,
+			},
 			"attribute_values": schema.SetNestedAttribute{
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -163,7 +234,54 @@ func (r *IncidentCatalogEntryResource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyCatalog)
+	r.dashboard = client.DashboardURL
+	r.catalogTypes = client.CatalogTypes
+}
+
+// ModifyPlan checks attribute values are bound to attributes in the catalog type's
+// schema at plan time, skipping any bound to attributes that don't exist yet. Whether
+// an attribute is an array may change in the same plan, so that's left to the API.
+//
+// Catalog types come from the shared cache, so planning many entries of a type loads it
+// once, and entries with no changes aren't checked at all.
+func (r *IncidentCatalogEntryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	var (
+		catalogTypeID   types.String
+		attributeValues types.Set
+	)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("catalog_type_id"), &catalogTypeID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attribute_values"), &attributeValues)...)
+	if resp.Diagnostics.HasError() || catalogTypeID.IsUnknown() || attributeValues.IsUnknown() {
+		return
+	}
+
+	values := []CatalogEntryAttributeValue{}
+	resp.Diagnostics.Append(attributeValues.ElementsAs(ctx, &values, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogType, err := r.catalogTypes.get(ctx, r.client, catalogTypeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
+		return
+	}
+
+	attributes := lo.KeyBy(catalogType.Schema.Attributes, func(attribute client.CatalogTypeAttributeV2) string {
+		return attribute.Id
+	})
+	for _, value := range values {
+		if value.Attribute.IsUnknown() {
+			continue
+		}
+
+		resp.Diagnostics.Append(validateCatalogAttributeID(attributes, value.Attribute.ValueString(), path.Root("attribute_values"))...)
+	}
 }
 
 func (r *IncidentCatalogEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -177,12 +295,17 @@ func (r *IncidentCatalogEntryResource) Create(ctx context.Context, req resource.
 	if !data.Rank.IsNull() {
 		rank = lo.ToPtr(int32(data.Rank.ValueInt64()))
 	}
-	var aliases []string
-	if !data.Aliases.IsUnknown() {
-		if diags := data.Aliases.ElementsAs(ctx, &aliases, false); diags.HasError() {
-			resp.Diagnostics.AddError("Client Error", "Unable to read aliases")
-			return
-		}
+	aliases, diags := stringElements(ctx, data.Aliases)
+	if diags.HasError() {
+		resp.Diagnostics.AddError("Client Error", "Unable to read aliases")
+		return
+	}
+
+	managed, diags := stringElements(ctx, data.ManagedAttributes)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(data.checkManagedAttributes(managed)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	result, err := r.client.CatalogV2CreateEntryWithResponse(ctx, client.CreateEntryRequestBody{
@@ -193,7 +316,7 @@ func (r *IncidentCatalogEntryResource) Create(ctx context.Context, req resource.
 		AttributeValues: data.buildAttributeValues(),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalog entry, got error: %s", err))
@@ -201,7 +324,8 @@ func (r *IncidentCatalogEntryResource) Create(ctx context.Context, req resource.
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a catalog entry resource with id=%s", result.JSON201.CatalogEntry.Id))
-	data = r.buildModel(result.JSON201.CatalogEntry)
+	data = r.buildModel(result.JSON201.CatalogEntry, managed)
+	data.AppURL = r.dashboard.Build(ctx, "catalog", data.CatalogTypeID.ValueString(), data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -212,6 +336,12 @@ func (r *IncidentCatalogEntryResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	managed, diags := stringElements(ctx, data.ManagedAttributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := r.client.CatalogV2ShowEntryWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entry, got error: %s", err))
@@ -224,7 +354,13 @@ func (r *IncidentCatalogEntryResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CatalogEntry)
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entry, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.CatalogEntry, managed)
+	data.AppURL = r.dashboard.Build(ctx, "catalog", data.CatalogTypeID.ValueString(), data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -239,29 +375,58 @@ func (r *IncidentCatalogEntryResource) Update(ctx context.Context, req resource.
 	if !data.Rank.IsNull() {
 		rank = lo.ToPtr(int32(data.Rank.ValueInt64()))
 	}
-	var aliases []string
-	if !data.Aliases.IsUnknown() {
-		if diags := data.Aliases.ElementsAs(ctx, &aliases, false); diags.HasError() {
-			resp.Diagnostics.AddError("Client Error", "Unable to read aliases")
+	aliases, diags := stringElements(ctx, data.Aliases)
+	if diags.HasError() {
+		resp.Diagnostics.AddError("Client Error", "Unable to read aliases")
+		return
+	}
+
+	managed, diags := stringElements(ctx, data.ManagedAttributes)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(data.checkManagedAttributes(managed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attributeValues := data.buildAttributeValues()
+	if managed != nil {
+		// Preserve the values of any attributes we don't manage, as the API replaces all
+		// attribute values at once.
+		unlock := lockObject(data.ID.ValueString())
+		defer unlock()
+
+		existing, err := r.client.CatalogV2ShowEntryWithResponse(ctx, data.ID.ValueString())
+		if err == nil && existing.StatusCode() >= 400 {
+			err = clientError(existing.StatusCode(), existing.Body)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entry, got error: %s", err))
 			return
 		}
+
+		for attributeID, value := range catalogEntryAttributeValuePayloads(existing.JSON200.CatalogEntry.AttributeValues) {
+			if !lo.Contains(managed, attributeID) {
+				attributeValues[attributeID] = value
+			}
+		}
 	}
 
 	result, err := r.client.CatalogV2UpdateEntryWithResponse(ctx, data.ID.ValueString(), client.UpdateEntryRequestBody{
 		Name:            data.Name.ValueString(),
 		Rank:            rank,
 		Aliases:         &aliases,
-		AttributeValues: data.buildAttributeValues(),
+		AttributeValues: attributeValues,
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog entry, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CatalogEntry)
+	data = r.buildModel(result.JSON200.CatalogEntry, managed)
+	data.AppURL = r.dashboard.Build(ctx, "catalog", data.CatalogTypeID.ValueString(), data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -272,7 +437,10 @@ func (r *IncidentCatalogEntryResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := r.client.CatalogV2DestroyEntry(ctx, data.ID.ValueString())
+	result, err := r.client.CatalogV2DestroyEntryWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog entry, got error: %s", err))
 		return
@@ -283,34 +451,16 @@ func (r *IncidentCatalogEntryResource) ImportState(ctx context.Context, req reso
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *IncidentCatalogEntryResource) buildModel(entry client.CatalogEntryV2) *IncidentCatalogEntryResourceModel {
+// buildModel builds the model for an entry, including only the values of the managed
+// attributes if managed is non-nil.
+func (r *IncidentCatalogEntryResource) buildModel(entry client.CatalogEntryV2, managed []string) *IncidentCatalogEntryResourceModel {
 	values := []CatalogEntryAttributeValue{}
 	for attributeID, binding := range entry.AttributeValues {
-		value := CatalogEntryAttributeValue{
-			Attribute:  types.StringValue(attributeID),
-			ArrayValue: types.ListNull(types.StringType),
-		}
-		// The API can behave weirdly in the case of empty arrays and omit the field entirely.
-		// This is painful for us as terraform will see the omission as a diff against the
-		// state, so we paper over the issue by instantiating an empty array value if we think
-		// we're seeing the weirdness.
-		if binding.Value == nil && binding.ArrayValue == nil {
-			binding.ArrayValue = lo.ToPtr([]client.CatalogEntryEngineParamBindingValueV2{})
+		if managed != nil && !lo.Contains(managed, attributeID) {
+			continue // managed elsewhere, such as by incident_catalog_entry_attribute_value
 		}
 
-		if binding.Value != nil {
-			value.Value = types.StringValue(*binding.Value.Literal)
-		}
-		if binding.ArrayValue != nil {
-			elements := []attr.Value{}
-			for _, value := range *binding.ArrayValue {
-				elements = append(elements, types.StringValue(*value.Literal))
-			}
-
-			value.ArrayValue = types.ListValueMust(types.StringType, elements)
-		}
-
-		values = append(values, value)
+		values = append(values, buildCatalogEntryAttributeValue(attributeID, binding))
 	}
 
 	// This ensures we get a stable read of the resource, rather than hitting
@@ -319,17 +469,18 @@ func (r *IncidentCatalogEntryResource) buildModel(entry client.CatalogEntryV2) *
 		return values[i].Attribute.ValueString() < values[j].Attribute.ValueString()
 	})
 
-	aliases := []attr.Value{}
-	for _, alias := range entry.Aliases {
-		aliases = append(aliases, types.StringValue(alias))
+	model := &IncidentCatalogEntryResourceModel{
+		ID:                types.StringValue(entry.Id),
+		CatalogTypeID:     types.StringValue(entry.CatalogTypeId),
+		Name:              types.StringValue(entry.Name),
+		Aliases:           stringListValue(entry.Aliases),
+		Rank:              types.Int64Value(int64(entry.Rank)),
+		AttributeValues:   values,
+		ManagedAttributes: types.SetNull(types.StringType),
 	}
-
-	return &IncidentCatalogEntryResourceModel{
-		ID:              types.StringValue(entry.Id),
-		CatalogTypeID:   types.StringValue(entry.CatalogTypeId),
-		Name:            types.StringValue(entry.Name),
-		Aliases:         types.ListValueMust(types.StringType, aliases),
-		Rank:            types.Int64Value(int64(entry.Rank)),
-		AttributeValues: values,
+	if managed != nil {
+		model.ManagedAttributes = stringSetValue(managed)
 	}
+
+	return model
 }
@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -21,11 +21,13 @@ import (
 )
 
 var (
-	_ resource.Resource = &IncidentCatalogTypeAttributeResource{}
+	_ resource.Resource                   = &IncidentCatalogTypeAttributeResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentCatalogTypeAttributeResource{}
 )
 
 type IncidentCatalogTypeAttributeResource struct {
-	client *client.ClientWithResponses
+	client       *client.ClientWithResponses
+	catalogTypes *catalogTypesCache
 }
 
 type IncidentCatalogTypeAttributesResourceModel struct {
@@ -36,6 +38,7 @@ type IncidentCatalogTypeAttributesResourceModel struct {
 	Array             types.Bool   `tfsdk:"array"`
 	BacklinkAttribute types.String `tfsdk:"backlink_attribute"`
 	Path              types.List   `tfsdk:"path"`
+	Position          types.Int64  `tfsdk:"position"`
 }
 
 func (m IncidentCatalogTypeAttributesResourceModel) buildAttribute(ctx context.Context) client.CatalogTypeAttributePayloadV2 {
@@ -66,8 +69,8 @@ func (m IncidentCatalogTypeAttributesResourceModel) buildAttribute(ctx context.C
 		mode = lo.ToPtr(client.CatalogTypeAttributePayloadV2ModePath)
 
 		// Do a little dance to get the path into the right format.
-		pathAsStrings := []string{}
-		if diags := m.Path.ElementsAs(ctx, &pathAsStrings, false); diags.HasError() {
+		pathAsStrings, diags := stringElements(ctx, m.Path)
+		if diags.HasError() {
 			panic(spew.Sdump(diags.Errors()))
 		}
 
@@ -140,10 +143,26 @@ func (r *IncidentCatalogTypeAttributeResource) Schema(ctx context.Context, req r
 				},
 				Optional: true,
 			},
+			"position": schema.Int64Attribute{
+				MarkdownDescription: `The zero-based position of this attribute in the catalog type's schema, which controls the order attributes are shown in the dashboard. If unset, new attributes are added to the end and existing attributes keep their place, so reordering your configuration never reorders the dashboard.`,
+				Optional:            true,
+			},
 		},
 	}
 }
 
+func (r *IncidentCatalogTypeAttributeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *IncidentCatalogTypeAttributesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Position.IsNull() && !data.Position.IsUnknown() && data.Position.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("position"), "Invalid position", "Position must be zero or greater.")
+	}
+}
+
 func (r *IncidentCatalogTypeAttributeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -159,7 +178,8 @@ func (r *IncidentCatalogTypeAttributeResource) Configure(ctx context.Context, re
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyCatalog)
+	r.catalogTypes = client.CatalogTypes
 }
 
 func (r *IncidentCatalogTypeAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -178,6 +198,7 @@ func (r *IncidentCatalogTypeAttributeResource) Create(ctx context.Context, req r
 
 		// Add our new attribute.
 		attributes = append(attributes, data.buildAttribute(ctx))
+		attributes = moveAttribute(attributes, data.Name.ValueString(), data.Position)
 
 		var err error
 		result, err = r.client.CatalogV2UpdateTypeSchemaWithResponse(ctx, catalogType.Id, client.UpdateTypeSchemaRequestBody{
@@ -185,7 +206,7 @@ func (r *IncidentCatalogTypeAttributeResource) Create(ctx context.Context, req r
 			Attributes: attributes,
 		})
 		if err == nil && result.StatusCode() >= 400 {
-			err = fmt.Errorf(string(result.Body))
+			err = clientError(result.StatusCode(), result.Body)
 		}
 		if err != nil {
 			return errors.Wrap(err, "Unable to update catalog type schema, got error")
@@ -210,7 +231,7 @@ func (r *IncidentCatalogTypeAttributeResource) Create(ctx context.Context, req r
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("Updated catalog type schema for id=%s", result.JSON200.CatalogType.Id))
-	data = r.buildModel(result.JSON200.CatalogType, attributeID)
+	data = r.buildModel(result.JSON200.CatalogType, attributeID, data.Position)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -223,14 +244,14 @@ func (r *IncidentCatalogTypeAttributeResource) Read(ctx context.Context, req res
 
 	result, err := r.client.CatalogV2ShowTypeWithResponse(ctx, data.CatalogTypeID.ValueString())
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CatalogType, data.ID.ValueString())
+	data = r.buildModel(result.JSON200.CatalogType, data.ID.ValueString(), data.Position)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -264,6 +285,7 @@ func (r *IncidentCatalogTypeAttributeResource) Update(ctx context.Context, req r
 			// We weren't here, so add us to the end.
 			attributes = append(attributes, data.buildAttribute(ctx))
 		}
+		attributes = moveAttribute(attributes, data.Name.ValueString(), data.Position)
 
 		tflog.Trace(ctx, fmt.Sprintf("Updating catalog type with attributes: %v", attributes))
 		var err error
@@ -272,7 +294,7 @@ func (r *IncidentCatalogTypeAttributeResource) Update(ctx context.Context, req r
 			Attributes: attributes,
 		})
 		if err == nil && result.StatusCode() >= 400 {
-			err = fmt.Errorf(string(result.Body))
+			err = clientError(result.StatusCode(), result.Body)
 		}
 		if err != nil {
 			return errors.Wrap(err, "Unable to update catalog type schema, got error")
@@ -301,7 +323,7 @@ func (r *IncidentCatalogTypeAttributeResource) Update(ctx context.Context, req r
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("Updated catalog type schema for catalog type with id=%s", result.JSON200.CatalogType.Id))
-	data = r.buildModel(result.JSON200.CatalogType, attributeID)
+	data = r.buildModel(result.JSON200.CatalogType, attributeID, data.Position)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -327,7 +349,7 @@ func (r *IncidentCatalogTypeAttributeResource) Delete(ctx context.Context, req r
 			Attributes: attributes,
 		})
 		if err == nil && result.StatusCode() >= 400 {
-			err = fmt.Errorf(string(result.Body))
+			err = clientError(result.StatusCode(), result.Body)
 		}
 		if err != nil {
 			return errors.Wrap(err, "Unable to update catalog type schema, got error")
@@ -341,13 +363,17 @@ func (r *IncidentCatalogTypeAttributeResource) Delete(ctx context.Context, req r
 	}
 }
 
-func (r *IncidentCatalogTypeAttributeResource) buildModel(catalogType client.CatalogTypeV2, attributeID string) *IncidentCatalogTypeAttributesResourceModel {
+// buildModel generates a terraform model for the attribute from its catalog type. We
+// only track the attribute's position if it was set, so that changes in order made
+// elsewhere don't show up as a diff.
+func (r *IncidentCatalogTypeAttributeResource) buildModel(catalogType client.CatalogTypeV2, attributeID string, position types.Int64) *IncidentCatalogTypeAttributesResourceModel {
 	result := &IncidentCatalogTypeAttributesResourceModel{
 		ID:            types.StringValue(attributeID),
 		CatalogTypeID: types.StringValue(catalogType.Id),
+		Position:      types.Int64Null(),
 	}
 
-	for _, attribute := range catalogType.Schema.Attributes {
+	for idx, attribute := range catalogType.Schema.Attributes {
 		if attribute.Id == attributeID {
 			result.Name = types.StringValue(attribute.Name)
 			result.Type = types.StringValue(attribute.Type)
@@ -358,11 +384,12 @@ func (r *IncidentCatalogTypeAttributeResource) buildModel(catalogType client.Cat
 
 			result.Path = types.ListNull(types.StringType)
 			if attribute.Path != nil {
-				path := []attr.Value{}
-				for _, item := range *attribute.Path {
-					path = append(path, types.StringValue(item.AttributeId))
-				}
-				result.Path = types.ListValueMust(types.StringType, path)
+				result.Path = stringListValue(lo.Map(*attribute.Path, func(item client.CatalogTypeAttributePathItemV2, _ int) string {
+					return item.AttributeId
+				}))
+			}
+			if !position.IsNull() {
+				result.Position = types.Int64Value(int64(idx))
 			}
 			break
 		}
@@ -371,6 +398,26 @@ func (r *IncidentCatalogTypeAttributeResource) buildModel(catalogType client.Cat
 	return result
 }
 
+// moveAttribute moves the named attribute to the given position in the schema, or
+// past the end if the schema is shorter. A null position leaves the schema as-is.
+func moveAttribute(attributes []client.CatalogTypeAttributePayloadV2, name string, position types.Int64) []client.CatalogTypeAttributePayloadV2 {
+	if position.IsNull() || position.IsUnknown() {
+		return attributes
+	}
+
+	attribute, idx, ok := lo.FindIndexOf(attributes, func(attribute client.CatalogTypeAttributePayloadV2) bool {
+		return attribute.Name == name
+	})
+	if !ok {
+		return attributes
+	}
+
+	remaining := append(append([]client.CatalogTypeAttributePayloadV2{}, attributes[:idx]...), attributes[idx+1:]...)
+	target := lo.Clamp(int(position.ValueInt64()), 0, len(remaining))
+
+	return append(remaining[:target], append([]client.CatalogTypeAttributePayloadV2{attribute}, remaining[target:]...)...)
+}
+
 var (
 	catalogTypeLocks = map[string]*sync.Mutex{}
 	catalogTypeMutex sync.Mutex
@@ -391,12 +438,15 @@ func (r *IncidentCatalogTypeAttributeResource) lockFor(ctx context.Context, cata
 
 	typeResult, err := r.client.CatalogV2ShowTypeWithResponse(ctx, catalogTypeID)
 	if err == nil && typeResult.StatusCode() >= 400 {
-		err = fmt.Errorf(string(typeResult.Body))
+		err = clientError(typeResult.StatusCode(), typeResult.Body)
 	}
 	if err != nil {
 		return errors.Wrap(err, "Unable to get catalog type, got error")
 	}
 
+	// Everything that takes the lock changes the schema, so any cached types are stale.
+	defer r.catalogTypes.invalidate()
+
 	return do(ctx, typeResult.JSON200.CatalogType)
 }
 
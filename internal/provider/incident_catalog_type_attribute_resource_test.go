@@ -2,13 +2,16 @@ package provider
 
 import (
 	"bytes"
+	"reflect"
 	"testing"
 	"text/template"
 
 	"github.com/Masterminds/sprig"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
 )
 
 func TestAccIncidentCatalogTypeAttributeResource(t *testing.T) {
@@ -82,3 +85,34 @@ func testAccIncidentCatalogTypeAttributeResourceConfig(attribute client.CatalogT
 
 	return buf.String()
 }
+
+func TestMoveAttribute(t *testing.T) {
+	schema := func(names ...string) []client.CatalogTypeAttributePayloadV2 {
+		return lo.Map(names, func(name string, _ int) client.CatalogTypeAttributePayloadV2 {
+			return client.CatalogTypeAttributePayloadV2{Name: name}
+		})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		move     string
+		position types.Int64
+		expected []string
+	}{
+		{"null position leaves schema as-is", "Owner", types.Int64Null(), []string{"Tier", "Owner", "Runbook"}},
+		{"moves to the start", "Runbook", types.Int64Value(0), []string{"Runbook", "Tier", "Owner"}},
+		{"moves towards the end", "Tier", types.Int64Value(1), []string{"Owner", "Tier", "Runbook"}},
+		{"position past the end moves to the end", "Tier", types.Int64Value(10), []string{"Owner", "Runbook", "Tier"}},
+		{"unknown attribute leaves schema as-is", "Missing", types.Int64Value(0), []string{"Tier", "Owner", "Runbook"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := moveAttribute(schema("Tier", "Owner", "Runbook"), tc.move, tc.position)
+			names := lo.Map(result, func(attribute client.CatalogTypeAttributePayloadV2, _ int) string {
+				return attribute.Name
+			})
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, names)
+			}
+		})
+	}
+}
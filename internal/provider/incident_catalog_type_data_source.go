@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/incident-io/terraform-provider-incident/internal/apischema"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
 	"github.com/samber/lo"
@@ -21,13 +22,36 @@ func NewIncidentCatalogTypeDataSource() datasource.DataSource {
 }
 
 type IncidentCatalogTypeDataSource struct {
-	client *client.ClientWithResponses
+	client       *client.ClientWithResponses
+	dashboard    *DashboardURL
+	catalogTypes *catalogTypesCache
+}
+
+type IncidentCatalogTypeDataSourceModel struct {
+	ID            types.String                             `tfsdk:"id"`
+	Name          types.String                             `tfsdk:"name"`
+	TypeName      types.String                             `tfsdk:"type_name"`
+	Description   types.String                             `tfsdk:"description"`
+	SourceRepoURL types.String                             `tfsdk:"source_repo_url"`
+	AppURL        types.String                             `tfsdk:"app_url"`
+	Attributes    []IncidentCatalogTypeDataSourceAttribute `tfsdk:"attributes"`
+}
+
+type IncidentCatalogTypeDataSourceAttribute struct {
+	ID    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Array types.Bool   `tfsdk:"array"`
 }
 
 func (i *IncidentCatalogTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "This data source provides information about a catalog type.",
+		MarkdownDescription: "This data source provides information about a catalog type, looked up by `name` or `type_name`, including the attributes in its schema. Use it to reference types created outside of Terraform, such as by the catalog importer, without hardcoding their IDs.",
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this catalog type in the incident.io dashboard.",
+				Computed:            true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: apischema.Docstring("CatalogTypeV2ResponseBody", "id"),
 				Computed:            true,
@@ -50,6 +74,30 @@ func (i *IncidentCatalogTypeDataSource) Schema(ctx context.Context, req datasour
 				MarkdownDescription: "The url of the external repository where this type is managed. When set, users will not be able to edit the catalog type (or its entries) via the UI, and will instead be provided a link to this URL.",
 				Computed:            true,
 			},
+			"attributes": schema.ListNestedAttribute{
+				MarkdownDescription: "The attributes in the catalog type's schema, in the order they're shown in the dashboard.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of this attribute.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of this attribute.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of this attribute.",
+							Computed:            true,
+						},
+						"array": schema.BoolAttribute{
+							MarkdownDescription: "Whether this attribute is an array or scalar.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -69,7 +117,9 @@ func (i *IncidentCatalogTypeDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	i.client = client.Client
+	i.client = client.ClientFor(apiKeyFamilyCatalog)
+	i.dashboard = client.DashboardURL
+	i.catalogTypes = client.CatalogTypes
 }
 
 func (i *IncidentCatalogTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -77,16 +127,13 @@ func (i *IncidentCatalogTypeDataSource) Metadata(ctx context.Context, req dataso
 }
 
 func (i *IncidentCatalogTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data IncidentCatalogTypeResourceModel
+	var data IncidentCatalogTypeDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	result, err := i.client.CatalogV2ListTypesWithResponse(ctx)
-	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
-	}
+	catalogTypes, err := i.catalogTypes.list(ctx, i.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog types, got error: %s", err))
 		return
@@ -97,7 +144,6 @@ func (i *IncidentCatalogTypeDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	catalogTypes := result.JSON200.CatalogTypes
 	if !data.Name.IsNull() {
 		catalogTypes = lo.Filter(catalogTypes, func(ct client.CatalogTypeV2, _ int) bool {
 			return ct.Name == data.Name.ValueString()
@@ -119,7 +165,27 @@ func (i *IncidentCatalogTypeDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	modelResp := new(IncidentCatalogTypeResource).buildModel(*catalogType)
+	model := new(IncidentCatalogTypeResource).buildModel(*catalogType, &IncidentCatalogTypeResourceModel{})
+	modelResp := IncidentCatalogTypeDataSourceModel{
+		ID:            model.ID,
+		Name:          model.Name,
+		TypeName:      model.TypeName,
+		Description:   model.Description,
+		SourceRepoURL: model.SourceRepoURL,
+		AppURL:        i.dashboard.Build(ctx, "catalog", model.ID.ValueString()),
+		Attributes:    buildCatalogTypeDataSourceAttributes(*catalogType),
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
 }
+
+func buildCatalogTypeDataSourceAttributes(catalogType client.CatalogTypeV2) []IncidentCatalogTypeDataSourceAttribute {
+	return lo.Map(catalogType.Schema.Attributes, func(attribute client.CatalogTypeAttributeV2, _ int) IncidentCatalogTypeDataSourceAttribute {
+		return IncidentCatalogTypeDataSourceAttribute{
+			ID:    types.StringValue(attribute.Id),
+			Name:  types.StringValue(attribute.Name),
+			Type:  types.StringValue(attribute.Type),
+			Array: types.BoolValue(attribute.Array),
+		}
+	})
+}
@@ -31,6 +31,13 @@ func TestAccIncidentCatalogTypeDataSource(t *testing.T) {
 						"data.incident_catalog_type.by_name", "name", catalogTypeDefault().Name),
 					resource.TestCheckResourceAttr(
 						"data.incident_catalog_type.by_type_name", "type_name", typeName),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_type.by_name", "attributes.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_type.by_name", "attributes.0.name", "Owner"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_catalog_type.by_name", "attributes.0.id",
+						"incident_catalog_type_attribute.owner", "id"),
 				),
 			},
 		},
@@ -43,8 +50,18 @@ resource "incident_catalog_type" "example" {
   type_name    = {{ quote .ResourceTypeName }}
   description = {{ quote .ResourceDescription }}
 }
+
+resource "incident_catalog_type_attribute" "owner" {
+  catalog_type_id = incident_catalog_type.example.id
+
+  name = "Owner"
+  type = "String"
+}
+
 data "incident_catalog_type" "by_name" {
   name = incident_catalog_type.example.name
+
+  depends_on = [incident_catalog_type_attribute.owner]
 }
 
 data "incident_catalog_type" "by_type_name" {
@@ -3,36 +3,89 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/incident-io/terraform-provider-incident/internal/apischema"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
 )
 
 var (
-	_ resource.Resource                = &IncidentCatalogTypeResource{}
-	_ resource.ResourceWithImportState = &IncidentCatalogTypeResource{}
+	_ resource.Resource                   = &IncidentCatalogTypeResource{}
+	_ resource.ResourceWithImportState    = &IncidentCatalogTypeResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentCatalogTypeResource{}
+	_ planmodifier.Map                    = catalogTypeSchemaAttributeIDs{}
 )
 
 type IncidentCatalogTypeResource struct {
 	client           *client.ClientWithResponses
 	terraformVersion string
+	dashboard        *DashboardURL
+	typeNamePrefix   string
+	catalogTypes     *catalogTypesCache
 }
 
 type IncidentCatalogTypeResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	TypeName      types.String `tfsdk:"type_name"`
-	Description   types.String `tfsdk:"description"`
-	SourceRepoURL types.String `tfsdk:"source_repo_url"`
+	ID                       types.String                         `tfsdk:"id"`
+	Name                     types.String                         `tfsdk:"name"`
+	TypeName                 types.String                         `tfsdk:"type_name"`
+	Description              types.String                         `tfsdk:"description"`
+	SourceRepoURL            types.String                         `tfsdk:"source_repo_url"`
+	AppURL                   types.String                         `tfsdk:"app_url"`
+	StandardAttributes       types.String                         `tfsdk:"standard_attributes"`
+	ExternallyManagedEntries types.Bool                           `tfsdk:"externally_managed_entries"`
+	Color                    types.String                         `tfsdk:"color"`
+	Icon                     types.String                         `tfsdk:"icon"`
+	Ranked                   types.Bool                           `tfsdk:"ranked"`
+	Schema                   []IncidentCatalogTypeSchemaAttribute `tfsdk:"schema"`
+	SchemaAttributeIDs       types.Map                            `tfsdk:"schema_attribute_ids"`
 }
 
+type IncidentCatalogTypeSchemaAttribute struct {
+	Name  types.String `tfsdk:"name"`
+	Type  types.String `tfsdk:"type"`
+	Array types.Bool   `tfsdk:"array"`
+}
+
+// catalogTypeEntriesAnnotation marks a catalog type whose entries are managed outside
+// of Terraform, such as by the catalog importer, when set to "external".
+const catalogTypeEntriesAnnotation = "incident.io/terraform/entries"
+
+// catalogTypeStandardAttributes are presets of attributes for common catalog types,
+// keyed by the name of the preset. They're defined by this provider as a starting
+// point, rather than taken from incident.io.
+var catalogTypeStandardAttributes = map[string][]client.CatalogTypeAttributePayloadV2{
+	"service": {
+		{Name: "Owner", Type: "String"},
+		{Name: "Tier", Type: "String"},
+		{Name: "Repository", Type: "String"},
+		{Name: "Runbook", Type: "Text"},
+	},
+	"team": {
+		{Name: "Slack channel", Type: "String"},
+		{Name: "Email", Type: "String"},
+		{Name: "Members", Type: "String", Array: true},
+	},
+}
+
+// catalogTypeColors and catalogTypeIcons are the values the API accepts for the color
+// and icon a type is shown with in the dashboard.
+var (
+	catalogTypeColors = apischema.Enum("CatalogV2CreateTypeRequestBody", "color")
+	catalogTypeIcons  = apischema.Enum("CatalogV2CreateTypeRequestBody", "icon")
+)
+
 func NewIncidentCatalogTypeResource() resource.Resource {
 	return &IncidentCatalogTypeResource{}
 }
@@ -45,6 +98,13 @@ func (r *IncidentCatalogTypeResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: apischema.TagDocstring("Catalog V2"),
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this catalog type in the incident.io dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: apischema.Docstring("CatalogTypeV2ResponseBody", "id"),
@@ -58,8 +118,8 @@ func (r *IncidentCatalogTypeResource) Schema(ctx context.Context, req resource.S
 			},
 			"type_name": schema.StringAttribute{
 				Optional:            true,
-				Computed:            true, // If not provided, we'll use the generated ID
-				MarkdownDescription: apischema.Docstring("CatalogV2CreateTypeRequestBody", "type_name"),
+				Computed:            true, // If not provided, we'll use the provider's catalog_type_name_prefix or the generated ID
+				MarkdownDescription: apischema.Docstring("CatalogV2CreateTypeRequestBody", "type_name") + ". If not set, this is derived from `name` using the provider's `catalog_type_name_prefix`, when configured.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -72,10 +132,153 @@ func (r *IncidentCatalogTypeResource) Schema(ctx context.Context, req resource.S
 				MarkdownDescription: "The url of the external repository where this type is managed. When set, users will not be able to edit the catalog type (or its entries) via the UI, and will instead be provided a link to this URL.",
 				Optional:            true,
 			},
+			"standard_attributes": schema.StringAttribute{
+				MarkdownDescription: "A preset of attributes to add to the type's schema, one of " + describeStandardAttributes() + ". Presets are defined by this provider as a starting point for common types, rather than by incident.io. Attributes are added when the type is created or the preset changes, if the schema doesn't already have an attribute with that name, and are never removed. To manage one of them with `incident_catalog_type_attribute`, import it rather than declaring a new attribute of the same name.",
+				Optional:            true,
+			},
+			"externally_managed_entries": schema.BoolAttribute{
+				MarkdownDescription: "Whether the entries of this type are managed outside of Terraform, such as by the [catalog importer](https://github.com/incident-io/catalog-importer), while its schema is managed here. Requires `source_repo_url`, which is where users are sent to edit entries. Types with this set can't be used with `incident_catalog_entries`.",
+				Optional:            true,
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogV2CreateTypeRequestBody", "color") + ", one of " + describeValues(catalogTypeColors) + ". If not set, the API picks one.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"icon": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogV2CreateTypeRequestBody", "icon") + ", one of " + describeValues(catalogTypeIcons) + ". If not set, the API picks one.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ranked": schema.BoolAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogV2CreateTypeRequestBody", "ranked") + ", for types whose entries have a meaningful order, such as service tiers. Entries are ordered by the `rank` of each `incident_catalog_entry`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schema": schema.ListNestedAttribute{
+				MarkdownDescription: "The attributes of this type's schema, in the order they're shown in the dashboard. When set, this resource manages the whole schema: attributes that aren't listed are removed, and listed attributes are matched to existing ones by name, so renaming an attribute replaces it. Leave unset to manage attributes with `incident_catalog_type_attribute` instead, which also supports backlink and path attributes. Can't be used with `standard_attributes`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of this attribute.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of this attribute, such as `String` or the type name of another catalog type.",
+							Required:            true,
+						},
+						"array": schema.BoolAttribute{
+							MarkdownDescription: "Whether this attribute is an array or scalar.",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"schema_attribute_ids": schema.MapAttribute{
+				MarkdownDescription: "The ID of each attribute in `schema`, keyed by its name, for use in catalog entries.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					catalogTypeSchemaAttributeIDs{},
+				},
+			},
 		},
 	}
 }
 
+func (r *IncidentCatalogTypeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// We read each attribute separately rather than the whole model, as the schema may
+	// be unknown until apply, such as when it's built from a module variable.
+	var (
+		externallyManagedEntries           types.Bool
+		sourceRepoURL, color, icon, preset types.String
+		schemaAttributes                   types.List
+	)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("externally_managed_entries"), &externallyManagedEntries)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_repo_url"), &sourceRepoURL)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("color"), &color)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon"), &icon)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("standard_attributes"), &preset)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schema"), &schemaAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if externallyManagedEntries.ValueBool() && sourceRepoURL.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_repo_url"),
+			"Missing source_repo_url",
+			"Catalog types with externally_managed_entries must set source_repo_url, so users know where to edit entries.",
+		)
+	}
+
+	for _, attribute := range []struct {
+		name    string
+		value   types.String
+		allowed []string
+	}{
+		{"color", color, catalogTypeColors},
+		{"icon", icon, catalogTypeIcons},
+	} {
+		if attribute.value.IsNull() || attribute.value.IsUnknown() || lo.Contains(attribute.allowed, attribute.value.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute.name),
+			fmt.Sprintf("Unknown catalog type %s", attribute.name),
+			fmt.Sprintf("Expected one of %s, got: %s", describeValues(attribute.allowed), attribute.value.ValueString()),
+		)
+	}
+
+	if !schemaAttributes.IsNull() && !preset.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("standard_attributes"),
+			"Conflicting schema configuration",
+			"standard_attributes can't be used with schema. List the attributes you want in schema instead.",
+		)
+	}
+
+	// An unknown schema has no elements, so there are no names to check.
+	names := map[string]bool{}
+	for idx, element := range schemaAttributes.Elements() {
+		attribute, _ := element.(types.Object)
+		name := objectAttribute[types.String](attribute.Attributes(), "name")
+		if name.IsNull() || name.IsUnknown() {
+			continue
+		}
+		if names[name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schema").AtListIndex(idx).AtName("name"),
+				"Duplicate schema attribute",
+				fmt.Sprintf("Attribute names must be unique, but %q appears more than once.", name.ValueString()),
+			)
+		}
+		names[name.ValueString()] = true
+	}
+
+	if preset.IsNull() || preset.IsUnknown() {
+		return
+	}
+	if _, ok := catalogTypeStandardAttributes[preset.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("standard_attributes"),
+			"Unknown standard attributes preset",
+			fmt.Sprintf("Expected one of %s, got: %s", describeStandardAttributes(), preset.ValueString()),
+		)
+	}
+}
+
 func (r *IncidentCatalogTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -91,8 +294,11 @@ func (r *IncidentCatalogTypeResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyCatalog)
+	r.dashboard = client.DashboardURL
 	r.terraformVersion = client.TerraformVersion
+	r.typeNamePrefix = client.CatalogTypeNamePrefix
+	r.catalogTypes = client.CatalogTypes
 }
 
 func (r *IncidentCatalogTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -105,20 +311,30 @@ func (r *IncidentCatalogTypeResource) Create(ctx context.Context, req resource.C
 	requestBody := client.CreateTypeRequestBody{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
-		Annotations: &map[string]string{
-			"incident.io/terraform/version": r.terraformVersion,
-		},
+		Annotations: lo.ToPtr(r.buildAnnotations(data)),
 	}
 	if typeName := data.TypeName.ValueString(); typeName != "" {
 		requestBody.TypeName = &typeName
+	} else if r.typeNamePrefix != "" {
+		requestBody.TypeName = lo.ToPtr(catalogTypeNameFromPrefix(r.typeNamePrefix, data.Name.ValueString()))
 	}
 	if sourceRepoURL := data.SourceRepoURL.ValueString(); sourceRepoURL != "" {
 		requestBody.SourceRepoUrl = &sourceRepoURL
 	}
+	if color := data.Color.ValueString(); color != "" {
+		requestBody.Color = lo.ToPtr(client.CreateTypeRequestBodyColor(color))
+	}
+	if icon := data.Icon.ValueString(); icon != "" {
+		requestBody.Icon = lo.ToPtr(client.CreateTypeRequestBodyIcon(icon))
+	}
+	if !data.Ranked.IsNull() && !data.Ranked.IsUnknown() {
+		requestBody.Ranked = lo.ToPtr(data.Ranked.ValueBool())
+	}
 
+	defer r.catalogTypes.invalidate()
 	result, err := r.client.CatalogV2CreateTypeWithResponse(ctx, requestBody)
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalog type, got error: %s", err))
@@ -126,8 +342,32 @@ func (r *IncidentCatalogTypeResource) Create(ctx context.Context, req resource.C
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a catalog type resource with id=%s", result.JSON201.CatalogType.Id))
-	data = r.buildModel(result.JSON201.CatalogType)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Save the type before changing its schema, so that if that fails the type is
+	// tainted and replaced on the next apply, rather than left outside of Terraform.
+	catalogType := result.JSON201.CatalogType
+	resp.Diagnostics.Append(resp.State.Set(ctx, r.buildState(ctx, catalogType, data))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if preset := data.StandardAttributes; !preset.IsNull() {
+		if err := r.applyStandardAttributes(ctx, catalogType.Id, preset.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add standard attributes, got error: %s", err))
+			return
+		}
+	}
+
+	if data.Schema != nil {
+		updated, err := r.applySchema(ctx, catalogType.Id, data.Schema)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set catalog type schema, got error: %s", err))
+			return
+		}
+		catalogType = *updated
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, r.buildState(ctx, catalogType, data))...)
 }
 
 func (r *IncidentCatalogTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -139,20 +379,20 @@ func (r *IncidentCatalogTypeResource) Read(ctx context.Context, req resource.Rea
 
 	result, err := r.client.CatalogV2ShowTypeWithResponse(ctx, data.ID.ValueString())
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CatalogType)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, r.buildState(ctx, result.JSON200.CatalogType, data))...)
 }
 
 func (r *IncidentCatalogTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *IncidentCatalogTypeResourceModel
+	var data, state *IncidentCatalogTypeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -161,26 +401,50 @@ func (r *IncidentCatalogTypeResource) Update(ctx context.Context, req resource.U
 		Name: data.Name.ValueString(),
 		// TypeName cannot be changed once set
 		Description: data.Description.ValueString(),
-		Annotations: &map[string]string{
-			"incident.io/terraform/version": r.terraformVersion,
-		},
+		Annotations: lo.ToPtr(r.buildAnnotations(data)),
 	}
 
 	if sourceRepoURL := data.SourceRepoURL.ValueString(); sourceRepoURL != "" {
 		requestBody.SourceRepoUrl = &sourceRepoURL
 	}
+	if color := data.Color.ValueString(); color != "" {
+		requestBody.Color = lo.ToPtr(client.UpdateTypeRequestBodyColor(color))
+	}
+	if icon := data.Icon.ValueString(); icon != "" {
+		requestBody.Icon = lo.ToPtr(client.UpdateTypeRequestBodyIcon(icon))
+	}
+	if !data.Ranked.IsNull() && !data.Ranked.IsUnknown() {
+		requestBody.Ranked = lo.ToPtr(data.Ranked.ValueBool())
+	}
 
+	defer r.catalogTypes.invalidate()
 	result, err := r.client.CatalogV2UpdateTypeWithResponse(ctx, data.ID.ValueString(), requestBody)
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog type, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CatalogType)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if preset := data.StandardAttributes; !preset.IsNull() && !preset.Equal(state.StandardAttributes) {
+		if err := r.applyStandardAttributes(ctx, data.ID.ValueString(), preset.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add standard attributes, got error: %s", err))
+			return
+		}
+	}
+
+	catalogType := result.JSON200.CatalogType
+	if data.Schema != nil {
+		updated, err := r.applySchema(ctx, catalogType.Id, data.Schema)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set catalog type schema, got error: %s", err))
+			return
+		}
+		catalogType = *updated
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, r.buildState(ctx, catalogType, data))...)
 }
 
 func (r *IncidentCatalogTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -190,7 +454,11 @@ func (r *IncidentCatalogTypeResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.CatalogV2DestroyTypeWithResponse(ctx, data.ID.ValueString())
+	defer r.catalogTypes.invalidate()
+	result, err := r.client.CatalogV2DestroyTypeWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog type, got error: %s", err))
 		return
@@ -201,15 +469,219 @@ func (r *IncidentCatalogTypeResource) ImportState(ctx context.Context, req resou
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *IncidentCatalogTypeResource) buildModel(catalogType client.CatalogTypeV2) *IncidentCatalogTypeResourceModel {
+// buildState builds the model to save to state, keeping the preset from the previous
+// model as the API doesn't record which preset a type's attributes came from.
+func (r *IncidentCatalogTypeResource) buildState(ctx context.Context, catalogType client.CatalogTypeV2, previous *IncidentCatalogTypeResourceModel) *IncidentCatalogTypeResourceModel {
+	model := r.buildModel(catalogType, previous)
+	model.AppURL = r.dashboard.Build(ctx, "catalog", model.ID.ValueString())
+	model.StandardAttributes = previous.StandardAttributes
+
+	return model
+}
+
+// buildModel generates a terraform model from the API response. An explicit false for
+// externally_managed_entries is kept from the previous model, as the API only knows
+// whether the annotation is there or not. The schema is only tracked if the previous
+// model managed it, so types whose attributes are managed by
+// incident_catalog_type_attribute don't show a diff.
+func (r *IncidentCatalogTypeResource) buildModel(catalogType client.CatalogTypeV2, previous *IncidentCatalogTypeResourceModel) *IncidentCatalogTypeResourceModel {
 	model := &IncidentCatalogTypeResourceModel{
-		ID:          types.StringValue(catalogType.Id),
-		Name:        types.StringValue(catalogType.Name),
-		TypeName:    types.StringValue(catalogType.TypeName),
-		Description: types.StringValue(catalogType.Description),
+		ID:                       types.StringValue(catalogType.Id),
+		Name:                     types.StringValue(catalogType.Name),
+		TypeName:                 types.StringValue(catalogType.TypeName),
+		Description:              types.StringValue(catalogType.Description),
+		ExternallyManagedEntries: types.BoolNull(),
+		Color:                    types.StringValue(string(catalogType.Color)),
+		Icon:                     types.StringValue(string(catalogType.Icon)),
+		Ranked:                   types.BoolValue(catalogType.Ranked),
+		SchemaAttributeIDs:       types.MapNull(types.StringType),
+	}
+	if previous.Schema != nil {
+		model.Schema = lo.Map(catalogType.Schema.Attributes, func(attribute client.CatalogTypeAttributeV2, _ int) IncidentCatalogTypeSchemaAttribute {
+			return IncidentCatalogTypeSchemaAttribute{
+				Name:  types.StringValue(attribute.Name),
+				Type:  types.StringValue(attribute.Type),
+				Array: types.BoolValue(attribute.Array),
+			}
+		})
+		model.SchemaAttributeIDs = buildCatalogTypeAttributeIDs(catalogType)
 	}
 	if catalogType.SourceRepoUrl != nil {
 		model.SourceRepoURL = types.StringValue(*catalogType.SourceRepoUrl)
 	}
+	if catalogTypeHasExternalEntries(catalogType) {
+		model.ExternallyManagedEntries = types.BoolValue(true)
+	} else if !previous.ExternallyManagedEntries.IsNull() {
+		model.ExternallyManagedEntries = types.BoolValue(false)
+	}
 	return model
 }
+
+func (r *IncidentCatalogTypeResource) buildAnnotations(data *IncidentCatalogTypeResourceModel) map[string]string {
+	annotations := map[string]string{
+		terraformVersionAnnotation: r.terraformVersion,
+	}
+	if data.ExternallyManagedEntries.ValueBool() {
+		annotations[catalogTypeEntriesAnnotation] = "external"
+	}
+
+	return annotations
+}
+
+// catalogTypeHasExternalEntries returns whether the type's entries are managed outside
+// of Terraform.
+func catalogTypeHasExternalEntries(catalogType client.CatalogTypeV2) bool {
+	return catalogType.Annotations[catalogTypeEntriesAnnotation] == "external"
+}
+
+// applyStandardAttributes adds the attributes of a preset to the type's schema,
+// skipping any the schema already has an attribute of the same name for. This shares
+// the lock used by incident_catalog_type_attribute, so the two can't race to update
+// the schema.
+func (r *IncidentCatalogTypeResource) applyStandardAttributes(ctx context.Context, catalogTypeID, preset string) error {
+	attributeResource := &IncidentCatalogTypeAttributeResource{client: r.client, catalogTypes: r.catalogTypes}
+
+	return attributeResource.lockFor(ctx, catalogTypeID, func(ctx context.Context, catalogType client.CatalogTypeV2) error {
+		attributes := lo.Map(catalogType.Schema.Attributes, func(attribute client.CatalogTypeAttributeV2, _ int) client.CatalogTypeAttributePayloadV2 {
+			return attributeResource.attributeToPayload(attribute)
+		})
+
+		missing := lo.Filter(catalogTypeStandardAttributes[preset], func(standard client.CatalogTypeAttributePayloadV2, _ int) bool {
+			return !lo.ContainsBy(attributes, func(attribute client.CatalogTypeAttributePayloadV2) bool {
+				return attribute.Name == standard.Name
+			})
+		})
+		if len(missing) == 0 {
+			return nil
+		}
+
+		result, err := r.client.CatalogV2UpdateTypeSchemaWithResponse(ctx, catalogType.Id, client.UpdateTypeSchemaRequestBody{
+			Version:    catalogType.Schema.Version,
+			Attributes: append(attributes, missing...),
+		})
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return errors.Wrap(err, "Unable to update catalog type schema, got error")
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("added %d standard attributes to catalog type id=%s", len(missing), catalogType.Id))
+		return nil
+	})
+}
+
+// applySchema replaces the type's schema with the given attributes, sharing the lock
+// used by incident_catalog_type_attribute.
+func (r *IncidentCatalogTypeResource) applySchema(ctx context.Context, catalogTypeID string, attributes []IncidentCatalogTypeSchemaAttribute) (*client.CatalogTypeV2, error) {
+	attributeResource := &IncidentCatalogTypeAttributeResource{client: r.client, catalogTypes: r.catalogTypes}
+
+	var updated *client.CatalogTypeV2
+	err := attributeResource.lockFor(ctx, catalogTypeID, func(ctx context.Context, catalogType client.CatalogTypeV2) error {
+		result, err := r.client.CatalogV2UpdateTypeSchemaWithResponse(ctx, catalogType.Id, client.UpdateTypeSchemaRequestBody{
+			Version:    catalogType.Schema.Version,
+			Attributes: buildCatalogTypeSchemaPayload(catalogType.Schema.Attributes, attributes),
+		})
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return errors.Wrap(err, "Unable to update catalog type schema, got error")
+		}
+
+		tflog.Trace(ctx, fmt.Sprintf("set %d schema attributes on catalog type id=%s", len(attributes), catalogType.Id))
+		updated = &result.JSON200.CatalogType
+		return nil
+	})
+
+	return updated, err
+}
+
+// buildCatalogTypeSchemaPayload builds the attributes for a schema update, reusing the
+// ID of any existing attribute with the same name so its values on entries are kept.
+func buildCatalogTypeSchemaPayload(existing []client.CatalogTypeAttributeV2, attributes []IncidentCatalogTypeSchemaAttribute) []client.CatalogTypeAttributePayloadV2 {
+	return lo.Map(attributes, func(attribute IncidentCatalogTypeSchemaAttribute, _ int) client.CatalogTypeAttributePayloadV2 {
+		payload := client.CatalogTypeAttributePayloadV2{
+			Name:  attribute.Name.ValueString(),
+			Type:  attribute.Type.ValueString(),
+			Array: attribute.Array.ValueBool(),
+		}
+		if match, ok := lo.Find(existing, func(candidate client.CatalogTypeAttributeV2) bool {
+			return candidate.Name == payload.Name
+		}); ok {
+			payload.Id = lo.ToPtr(match.Id)
+		}
+
+		return payload
+	})
+}
+
+// catalogTypeSchemaAttributeIDs keeps schema_attribute_ids from state unless the schema
+// changes, as new attributes only get IDs when applied. Without a schema the map is
+// always null.
+type catalogTypeSchemaAttributeIDs struct{}
+
+func (m catalogTypeSchemaAttributeIDs) Description(ctx context.Context) string {
+	return "Keeps the attribute IDs from state unless the schema changes."
+}
+
+func (m catalogTypeSchemaAttributeIDs) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m catalogTypeSchemaAttributeIDs) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	var planSchema, stateSchema types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schema"), &planSchema)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planSchema.IsNull() {
+		resp.PlanValue = types.MapNull(types.StringType)
+		return
+	}
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schema"), &stateSchema)...)
+	if !resp.Diagnostics.HasError() && planSchema.Equal(stateSchema) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// describeStandardAttributes lists the available presets, for use in docs and errors.
+func describeStandardAttributes() string {
+	presets := lo.Keys(catalogTypeStandardAttributes)
+	sort.Strings(presets)
+
+	descriptions := lo.Map(presets, func(preset string, _ int) string {
+		names := lo.Map(catalogTypeStandardAttributes[preset], func(attribute client.CatalogTypeAttributePayloadV2, _ int) string {
+			return attribute.Name
+		})
+
+		return fmt.Sprintf("`%s` (%s)", preset, strings.Join(names, ", "))
+	})
+
+	return strings.Join(descriptions, ", ")
+}
+
+// describeValues formats a list of allowed values, for use in docs and errors.
+func describeValues(values []string) string {
+	return "`" + strings.Join(values, "`, `") + "`"
+}
+
+var catalogTypeNameSeparator = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// catalogTypeNameFromPrefix derives a type name from the provider's prefix pattern,
+// replacing the * with the catalog type's name in PascalCase, such that a prefix of
+// Custom["Acme*"] gives "Service tier" the type name Custom["AcmeServiceTier"].
+func catalogTypeNameFromPrefix(prefix, name string) string {
+	words := catalogTypeNameSeparator.Split(name, -1)
+	for idx, word := range words {
+		if word != "" {
+			words[idx] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return strings.Replace(prefix, "*", strings.Join(words, ""), 1)
+}
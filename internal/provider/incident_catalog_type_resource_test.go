@@ -2,6 +2,7 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -10,10 +11,135 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
 )
 
+func TestCatalogTypeNameFromPrefix(t *testing.T) {
+	for _, tc := range []struct {
+		prefix, name, want string
+	}{
+		{`Custom["Acme*"]`, "Service tier", `Custom["AcmeServiceTier"]`},
+		{`Custom["Acme*"]`, "ServiceTier", `Custom["AcmeServiceTier"]`},
+		{`Custom["*Acme"]`, "on-call team", `Custom["OnCallTeamAcme"]`},
+	} {
+		if got := catalogTypeNameFromPrefix(tc.prefix, tc.name); got != tc.want {
+			t.Errorf("catalogTypeNameFromPrefix(%q, %q) = %q, want %q", tc.prefix, tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestBuildCatalogTypeSchemaPayload(t *testing.T) {
+	existing := []client.CatalogTypeAttributeV2{
+		{Id: "01OWNER", Name: "Owner", Type: "String"},
+		{Id: "01TIER", Name: "Tier", Type: "String"},
+	}
+	attributes := []IncidentCatalogTypeSchemaAttribute{
+		{Name: types.StringValue("Tier"), Type: types.StringValue("String"), Array: types.BoolNull()},
+		{Name: types.StringValue("Members"), Type: types.StringValue("String"), Array: types.BoolValue(true)},
+	}
+
+	want := []client.CatalogTypeAttributePayloadV2{
+		{Id: lo.ToPtr("01TIER"), Name: "Tier", Type: "String"},
+		{Name: "Members", Type: "String", Array: true},
+	}
+	if got := buildCatalogTypeSchemaPayload(existing, attributes); !reflect.DeepEqual(got, want) {
+		t.Errorf("buildCatalogTypeSchemaPayload() = %v, want %v", got, want)
+	}
+}
+
+func TestIncidentCatalogTypeResourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp frameworkresource.SchemaResponse
+	new(IncidentCatalogTypeResource).Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	schemaType := configType.AttributeTypes["schema"].(tftypes.List)
+	attributeType := schemaType.ElementType.(tftypes.Object)
+
+	// object builds a config value, leaving any attributes we don't set null.
+	object := func(objectType tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+			if value, ok := values[name]; ok {
+				attributes[name] = value
+			}
+		}
+
+		return tftypes.NewValue(objectType, attributes)
+	}
+	schemaAttribute := func(name string) tftypes.Value {
+		return object(attributeType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+			"type": tftypes.NewValue(tftypes.String, "String"),
+		})
+	}
+
+	for _, tc := range []struct {
+		name           string
+		values         map[string]tftypes.Value
+		expectedErrors []string
+	}{
+		{
+			name: "valid",
+			values: map[string]tftypes.Value{
+				"color":  tftypes.NewValue(tftypes.String, catalogTypeColors[0]),
+				"schema": tftypes.NewValue(schemaType, []tftypes.Value{schemaAttribute("Owner"), schemaAttribute("Tier")}),
+			},
+		},
+		{
+			name: "duplicate schema attribute",
+			values: map[string]tftypes.Value{
+				"schema": tftypes.NewValue(schemaType, []tftypes.Value{schemaAttribute("Owner"), schemaAttribute("Owner")}),
+			},
+			expectedErrors: []string{"Duplicate schema attribute"},
+		},
+		{
+			name: "unknown schema attribute",
+			values: map[string]tftypes.Value{
+				"schema": tftypes.NewValue(schemaType, []tftypes.Value{schemaAttribute("Owner"), tftypes.NewValue(attributeType, tftypes.UnknownValue)}),
+			},
+		},
+		{
+			name: "unknown schema still checks other attributes",
+			values: map[string]tftypes.Value{
+				"color":                      tftypes.NewValue(tftypes.String, "Mauve"),
+				"externally_managed_entries": tftypes.NewValue(tftypes.Bool, true),
+				"schema":                     tftypes.NewValue(schemaType, tftypes.UnknownValue),
+				"standard_attributes":        tftypes.NewValue(tftypes.String, "service"),
+			},
+			expectedErrors: []string{"Missing source_repo_url", "Unknown catalog type color", "Conflicting schema configuration"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			values := lo.Assign(map[string]tftypes.Value{
+				"name":        tftypes.NewValue(tftypes.String, "Service"),
+				"description": tftypes.NewValue(tftypes.String, "Services in the catalog."),
+			}, tc.values)
+
+			req := frameworkresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: object(configType, values)},
+			}
+			var resp frameworkresource.ValidateConfigResponse
+			new(IncidentCatalogTypeResource).ValidateConfig(ctx, req, &resp)
+
+			summaries := lo.Map(resp.Diagnostics.Errors(), func(diag diag.Diagnostic, _ int) string {
+				return diag.Summary()
+			})
+			if strings.Join(summaries, ", ") != strings.Join(tc.expectedErrors, ", ") {
+				t.Errorf("expected errors %v, got %v", tc.expectedErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
 func TestAccIncidentCatalogTypeResource(t *testing.T) {
 	// Not setting the type name
 	resource.Test(t, resource.TestCase{
@@ -28,6 +154,8 @@ func TestAccIncidentCatalogTypeResource(t *testing.T) {
 						"incident_catalog_type.example", "name", catalogTypeDefault().Name),
 					resource.TestCheckResourceAttr(
 						"incident_catalog_type.example", "description", catalogTypeDefault().Description),
+					resource.TestCheckResourceAttrSet(
+						"incident_catalog_type.example", "app_url"),
 				),
 			},
 			// Import
@@ -46,6 +174,39 @@ func TestAccIncidentCatalogTypeResource(t *testing.T) {
 						"incident_catalog_type.example", "name", StableSuffix("Spaceships")),
 				),
 			},
+			// Set the color and icon
+			{
+				Config: testAccIncidentCatalogTypeResourceConfig(&client.CatalogTypeV2{
+					Name:  StableSuffix("Spaceships"),
+					Color: client.CatalogTypeV2ColorViolet,
+					Icon:  client.CatalogTypeV2IconServer,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "color", "violet"),
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "icon", "server"),
+				),
+			},
+			// Rank the type's entries
+			{
+				Config: testAccIncidentCatalogTypeResourceConfig(&client.CatalogTypeV2{
+					Name:   StableSuffix("Spaceships"),
+					Ranked: true,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "ranked", "true"),
+				),
+			},
+			// Reject colors the API doesn't support
+			{
+				Config: testAccIncidentCatalogTypeResourceConfig(&client.CatalogTypeV2{
+					Name:  StableSuffix("Spaceships"),
+					Color: client.CatalogTypeV2Color("purple"),
+				}),
+				ExpectError: regexp.MustCompile("Unknown catalog type color"),
+			},
 		},
 	})
 
@@ -92,6 +253,132 @@ func TestAccIncidentCatalogTypeResource(t *testing.T) {
 	})
 }
 
+func TestAccIncidentCatalogTypeResourceStandardAttributes(t *testing.T) {
+	config := fmt.Sprintf(`
+resource "incident_catalog_type" "example" {
+  name                = %q
+  description         = "Catalog Type Acceptance tests"
+  standard_attributes = "service"
+}
+`, StableSuffix("Standard Service"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "standard_attributes", "service"),
+				),
+			},
+			// Import, which can't know which preset was used
+			{
+				ResourceName:            "incident_catalog_type.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"standard_attributes"},
+			},
+		},
+	})
+}
+
+func TestAccIncidentCatalogTypeResourceExternallyManagedEntries(t *testing.T) {
+	config := fmt.Sprintf(`
+resource "incident_catalog_type" "example" {
+  name                       = %q
+  description                = "Catalog Type Acceptance tests"
+  source_repo_url            = "https://github.com/incident-io/catalog-importer"
+  externally_managed_entries = true
+}
+`, StableSuffix("Imported Service"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "externally_managed_entries", "true"),
+				),
+			},
+			// Import, which reads the flag back from the type's annotations
+			{
+				ResourceName:      "incident_catalog_type.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIncidentCatalogTypeResourceSchema(t *testing.T) {
+	config := func(attributes string) string {
+		return fmt.Sprintf(`
+resource "incident_catalog_type" "example" {
+  name        = %q
+  description = "Catalog Type Acceptance tests"
+  schema = [
+    %s
+  ]
+}
+`, StableSuffix("Inline Schema"), attributes)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a schema
+			{
+				Config: config(`{ name = "Owner", type = "String" },
+    { name = "Tier", type = "String" },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "schema.#", "2"),
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "schema.1.array", "false"),
+					resource.TestCheckResourceAttrSet(
+						"incident_catalog_type.example", "schema_attribute_ids.Owner"),
+				),
+			},
+			// Import, which doesn't know whether the schema is managed here
+			{
+				ResourceName:            "incident_catalog_type.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"schema", "schema_attribute_ids"},
+			},
+			// Remove one attribute and add another, keeping the ID of the one left
+			{
+				Config: config(`{ name = "Tier", type = "String" },
+    { name = "Members", type = "String", array = true },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "schema.#", "2"),
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "schema.1.array", "true"),
+					resource.TestCheckResourceAttr(
+						"incident_catalog_type.example", "schema_attribute_ids.%", "2"),
+					resource.TestCheckNoResourceAttr(
+						"incident_catalog_type.example", "schema_attribute_ids.Owner"),
+				),
+			},
+			// Reject duplicate attribute names
+			{
+				Config: config(`{ name = "Tier", type = "String" },
+    { name = "Tier", type = "Text" },`),
+				ExpectError: regexp.MustCompile("Duplicate schema attribute"),
+			},
+		},
+	})
+}
+
 func generateTypeName() string {
 	// The test run ID is a uuid, which won't be accepted. Strip it down to
 	// something allowed
@@ -108,6 +395,9 @@ resource "incident_catalog_type" "example" {
   name        = {{ quote .Name }}
   {{ if ne .TypeName "" }}type_name   = {{ quote .TypeName }}{{ end }}
   description = {{ quote .Description }}
+  {{ if ne .Color "" }}color       = {{ quote .Color }}{{ end }}
+  {{ if ne .Icon "" }}icon        = {{ quote .Icon }}{{ end }}
+  {{ if .Ranked }}ranked      = true{{ end }}
 }
 `))
 
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentCatalogTypeSchemaDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentCatalogTypeSchemaDataSource{}
+)
+
+func NewIncidentCatalogTypeSchemaDataSource() datasource.DataSource {
+	return &IncidentCatalogTypeSchemaDataSource{}
+}
+
+type IncidentCatalogTypeSchemaDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentCatalogTypeSchemaDataSourceModel struct {
+	CatalogTypeID types.String                             `tfsdk:"catalog_type_id"`
+	Version       types.Int64                              `tfsdk:"version"`
+	Attributes    []IncidentCatalogTypeDataSourceAttribute `tfsdk:"attributes"`
+	AttributeIDs  types.Map                                `tfsdk:"attribute_ids"`
+}
+
+func (i *IncidentCatalogTypeSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_type_schema"
+}
+
+func (i *IncidentCatalogTypeSchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source returns just the schema of a catalog type: the name, ID, type and array flag of each attribute. Use it in modules that manage catalog entries to map attribute names to IDs, and to check attribute bindings against the schema at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"catalog_type_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CatalogTypeV2ResponseBody", "id"),
+				Required:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the schema, which increases whenever its attributes change.",
+				Computed:            true,
+			},
+			"attributes": schema.ListNestedAttribute{
+				MarkdownDescription: "The attributes in the catalog type's schema, in the order they're shown in the dashboard.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of this attribute.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of this attribute.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of this attribute.",
+							Computed:            true,
+						},
+						"array": schema.BoolAttribute{
+							MarkdownDescription: "Whether this attribute is an array or scalar.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"attribute_ids": schema.MapAttribute{
+				MarkdownDescription: "The ID of each attribute, keyed by its name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (i *IncidentCatalogTypeSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyCatalog)
+}
+
+func (i *IncidentCatalogTypeSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentCatalogTypeSchemaDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.CatalogV2ShowTypeWithResponse(ctx, data.CatalogTypeID.ValueString())
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
+		return
+	}
+
+	catalogType := result.JSON200.CatalogType
+	data.Version = types.Int64Value(catalogType.Schema.Version)
+	data.Attributes = buildCatalogTypeDataSourceAttributes(catalogType)
+	data.AttributeIDs = buildCatalogTypeAttributeIDs(catalogType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildCatalogTypeAttributeIDs maps the name of each attribute in the catalog type's
+// schema to its ID. Attribute names are unique within a schema.
+func buildCatalogTypeAttributeIDs(catalogType client.CatalogTypeV2) types.Map {
+	return types.MapValueMust(types.StringType, lo.SliceToMap(catalogType.Schema.Attributes, func(attribute client.CatalogTypeAttributeV2) (string, attr.Value) {
+		return attribute.Name, types.StringValue(attribute.Id)
+	}))
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentCatalogTypeSchemaDataSource(t *testing.T) {
+	typeName := generateTypeName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "incident_catalog_type" "example" {
+  name        = "With schema"
+  type_name   = %q
+  description = "Used to test reading a catalog type's schema."
+}
+
+resource "incident_catalog_type_attribute" "owner" {
+  catalog_type_id = incident_catalog_type.example.id
+
+  name  = "Owners"
+  type  = "String"
+  array = true
+}
+
+data "incident_catalog_type_schema" "example" {
+  catalog_type_id = incident_catalog_type.example.id
+
+  depends_on = [incident_catalog_type_attribute.owner]
+}
+`, typeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_type_schema.example", "attributes.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_type_schema.example", "attributes.0.name", "Owners"),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_type_schema.example", "attributes.0.type", "String"),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_type_schema.example", "attributes.0.array", "true"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_catalog_type_schema.example", "attribute_ids.Owners",
+						"incident_catalog_type_attribute.owner", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.incident_catalog_type_schema.example", "version"),
+				),
+			},
+		},
+	})
+}
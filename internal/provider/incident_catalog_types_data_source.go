@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentCatalogTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentCatalogTypesDataSource{}
+)
+
+func NewIncidentCatalogTypesDataSource() datasource.DataSource {
+	return &IncidentCatalogTypesDataSource{}
+}
+
+type IncidentCatalogTypesDataSource struct {
+	client       *client.ClientWithResponses
+	dashboard    *DashboardURL
+	catalogTypes *catalogTypesCache
+}
+
+type IncidentCatalogTypesDataSourceModel struct {
+	Limit        types.Int64                                 `tfsdk:"limit"`
+	After        types.String                                `tfsdk:"after"`
+	TotalCount   types.Int64                                 `tfsdk:"total_count"`
+	CatalogTypes []IncidentCatalogTypesDataSourceCatalogType `tfsdk:"catalog_types"`
+}
+
+type IncidentCatalogTypesDataSourceCatalogType struct {
+	ID            types.String                             `tfsdk:"id"`
+	Name          types.String                             `tfsdk:"name"`
+	TypeName      types.String                             `tfsdk:"type_name"`
+	Description   types.String                             `tfsdk:"description"`
+	SourceRepoURL types.String                             `tfsdk:"source_repo_url"`
+	AppURL        types.String                             `tfsdk:"app_url"`
+	Attributes    []IncidentCatalogTypeDataSourceAttribute `tfsdk:"attributes"`
+}
+
+func (i *IncidentCatalogTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_types"
+}
+
+func (i *IncidentCatalogTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists every catalog type in your organisation, with the attributes in each type's schema. Use it to check a type exists before depending on it, or to generate resources for each type.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"catalog_types": schema.ListNestedAttribute{
+				MarkdownDescription: "The catalog types, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogTypeV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogV2CreateTypeRequestBody", "name"),
+							Computed:            true,
+						},
+						"type_name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogV2CreateTypeRequestBody", "type_name"),
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CatalogTypeV2ResponseBody", "description"),
+							Computed:            true,
+						},
+						"source_repo_url": schema.StringAttribute{
+							MarkdownDescription: "The url of the external repository where this type is managed, if any.",
+							Computed:            true,
+						},
+						"app_url": schema.StringAttribute{
+							MarkdownDescription: "Link to this catalog type in the incident.io dashboard.",
+							Computed:            true,
+						},
+						"attributes": schema.ListNestedAttribute{
+							MarkdownDescription: "The attributes in the catalog type's schema, in the order they're shown in the dashboard.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: "The ID of this attribute.",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The name of this attribute.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The type of this attribute.",
+										Computed:            true,
+									},
+									"array": schema.BoolAttribute{
+										MarkdownDescription: "Whether this attribute is an array or scalar.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentCatalogTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyCatalog)
+	i.dashboard = client.DashboardURL
+	i.catalogTypes = client.CatalogTypes
+}
+
+func (i *IncidentCatalogTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentCatalogTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogTypes, err := i.catalogTypes.list(ctx, i.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list catalog types, got error: %s", err))
+		return
+	}
+
+	data.CatalogTypes = lo.Map(catalogTypes, func(catalogType client.CatalogTypeV2, _ int) IncidentCatalogTypesDataSourceCatalogType {
+		model := new(IncidentCatalogTypeResource).buildModel(catalogType, &IncidentCatalogTypeResourceModel{})
+
+		return IncidentCatalogTypesDataSourceCatalogType{
+			ID:            model.ID,
+			Name:          model.Name,
+			TypeName:      model.TypeName,
+			Description:   model.Description,
+			SourceRepoURL: model.SourceRepoURL,
+			AppURL:        i.dashboard.Build(ctx, "catalog", model.ID.ValueString()),
+			Attributes:    buildCatalogTypeDataSourceAttributes(catalogType),
+		}
+	})
+	sort.Slice(data.CatalogTypes, func(a, b int) bool {
+		return data.CatalogTypes[a].Name.ValueString() < data.CatalogTypes[b].Name.ValueString()
+	})
+	data.TotalCount = types.Int64Value(int64(len(data.CatalogTypes)))
+
+	data.CatalogTypes = paginateSlice(data.CatalogTypes, func(catalogType IncidentCatalogTypesDataSourceCatalogType) string {
+		return catalogType.ID.ValueString()
+	}, data.Limit, data.After)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
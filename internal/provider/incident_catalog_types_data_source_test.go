@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentCatalogTypesDataSource(t *testing.T) {
+	typeName := generateTypeName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "incident_catalog_type" "example" {
+  name        = "Listed"
+  type_name   = %q
+  description = "Used to test listing catalog types."
+}
+
+data "incident_catalog_types" "all" {
+  depends_on = [incident_catalog_type.example]
+}
+
+data "incident_catalog_types" "first" {
+  limit = 1
+
+  depends_on = [incident_catalog_type.example]
+}
+`, typeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.incident_catalog_types.all", "catalog_types.*", map[string]string{
+							"name":      "Listed",
+							"type_name": typeName,
+						}),
+					resource.TestCheckResourceAttr(
+						"data.incident_catalog_types.first", "catalog_types.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_catalog_types.first", "total_count",
+						"data.incident_catalog_types.all", "total_count"),
+				),
+			},
+		},
+	})
+}
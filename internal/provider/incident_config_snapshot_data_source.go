@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentConfigSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentConfigSnapshotDataSource{}
+)
+
+func NewIncidentConfigSnapshotDataSource() datasource.DataSource {
+	return &IncidentConfigSnapshotDataSource{}
+}
+
+type IncidentConfigSnapshotDataSource struct {
+	providerData *IncidentProviderData
+}
+
+type IncidentConfigSnapshotDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	JSON types.String `tfsdk:"json"`
+}
+
+// configSnapshot is the configuration of an organisation, as returned by the API.
+// Fields are ordered and named to keep the JSON stable between snapshots.
+type configSnapshot struct {
+	CatalogTypes       []client.CatalogTypeV2                  `json:"catalog_types"`
+	CustomFields       []client.CustomFieldV2                  `json:"custom_fields"`
+	CustomFieldOptions map[string][]client.CustomFieldOptionV1 `json:"custom_field_options"`
+	IncidentRoles      []client.IncidentRoleV2                 `json:"incident_roles"`
+	IncidentStatuses   []client.IncidentStatusV1               `json:"incident_statuses"`
+	IncidentTypes      []client.IncidentTypeV1                 `json:"incident_types"`
+	Schedules          []client.ScheduleV2                     `json:"schedules"`
+	Severities         []client.SeverityV2                     `json:"severities"`
+	Workflows          []client.Workflow                       `json:"workflows"`
+}
+
+// configSnapshotVolatileFields change as the organisation is used rather than when
+// its configuration does, such as who is currently on call for a schedule, so we leave
+// them out of snapshots.
+var configSnapshotVolatileFields = []string{"current_shifts", "estimated_count", "last_synced_at", "updated_at"}
+
+func (i *IncidentConfigSnapshotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_snapshot"
+}
+
+func (i *IncidentConfigSnapshotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This data source captures the configuration of your organisation as a single JSON
+document, for disaster recovery or for cloning configuration into another environment.
+Write it somewhere durable with a resource such as ` + "`local_file`" + ` or an object storage
+bucket on each apply.
+
+The snapshot includes catalog types (but not their entries), custom fields and their
+options, incident roles, statuses and types, schedules, severities and workflows. It
+makes a request per custom field and per workflow, so can be slow for large
+organisations.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 checksum of the snapshot, which changes whenever the configuration does.",
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The snapshot, as indented JSON with a key for each type of object.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (i *IncidentConfigSnapshotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.providerData = client
+}
+
+func (i *IncidentConfigSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	snapshot, err := loadConfigSnapshot(ctx, i.providerData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to build config snapshot, got error: %s", err))
+		return
+	}
+
+	data, err := buildConfigSnapshotModel(snapshot)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode config snapshot, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func buildConfigSnapshotModel(snapshot *configSnapshot) (*IncidentConfigSnapshotDataSourceModel, error) {
+	encoded, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode into plain values so we can drop volatile fields, keeping numbers as they
+	// are rather than converting them to floats.
+	var decoded any
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	payload, err := json.MarshalIndent(withoutVolatileFields(decoded), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(payload)
+
+	return &IncidentConfigSnapshotDataSourceModel{
+		ID:   types.StringValue(hex.EncodeToString(checksum[:])),
+		JSON: types.StringValue(string(payload)),
+	}, nil
+}
+
+// loadConfigSnapshot loads every supported type of object from the API, using the
+// client for each object's family of API key.
+func loadConfigSnapshot(ctx context.Context, providerData *IncidentProviderData) (*configSnapshot, error) {
+	snapshot := &configSnapshot{
+		CustomFieldOptions: map[string][]client.CustomFieldOptionV1{},
+		Workflows:          []client.Workflow{},
+	}
+
+	catalogTypes, err := providerData.ClientFor(apiKeyFamilyCatalog).CatalogV2ListTypesWithResponse(ctx)
+	if err == nil && catalogTypes.StatusCode() >= 400 {
+		err = clientError(catalogTypes.StatusCode(), catalogTypes.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "listing catalog types")
+	}
+	snapshot.CatalogTypes = catalogTypes.JSON200.CatalogTypes
+
+	customFieldsClient := providerData.ClientFor(apiKeyFamilyCustomFields)
+	customFields, err := customFieldsClient.CustomFieldsV2ListWithResponse(ctx)
+	if err == nil && customFields.StatusCode() >= 400 {
+		err = clientError(customFields.StatusCode(), customFields.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "listing custom fields")
+	}
+	snapshot.CustomFields = customFields.JSON200.CustomFields
+
+	for _, customField := range snapshot.CustomFields {
+		snapshot.CustomFieldOptions[customField.Id], err = listCustomFieldOptions(ctx, customFieldsClient, customField.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	settingsClient := providerData.ClientFor(apiKeyFamilySettings)
+
+	roles, err := settingsClient.IncidentRolesV2ListWithResponse(ctx)
+	if err == nil && roles.StatusCode() >= 400 {
+		err = clientError(roles.StatusCode(), roles.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "listing incident roles")
+	}
+	snapshot.IncidentRoles = roles.JSON200.IncidentRoles
+
+	statuses, err := settingsClient.IncidentStatusesV1ListWithResponse(ctx)
+	if err == nil && statuses.StatusCode() >= 400 {
+		err = clientError(statuses.StatusCode(), statuses.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "listing incident statuses")
+	}
+	snapshot.IncidentStatuses = statuses.JSON200.IncidentStatuses
+
+	incidentTypes, err := settingsClient.IncidentTypesV1ListWithResponse(ctx)
+	if err == nil && incidentTypes.StatusCode() >= 400 {
+		err = clientError(incidentTypes.StatusCode(), incidentTypes.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "listing incident types")
+	}
+	snapshot.IncidentTypes = incidentTypes.JSON200.IncidentTypes
+
+	severities, err := settingsClient.SeveritiesV1ListWithResponse(ctx)
+	if err == nil && severities.StatusCode() >= 400 {
+		err = clientError(severities.StatusCode(), severities.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "listing severities")
+	}
+	snapshot.Severities = severities.JSON200.Severities
+
+	snapshot.Schedules, err = listSchedules(ctx, providerData.ClientFor(apiKeyFamilyOnCall))
+	if err != nil {
+		return nil, errors.Wrap(err, "listing schedules")
+	}
+
+	workflowsClient := providerData.ClientFor(apiKeyFamilyWorkflows)
+	workflows, err := workflowsClient.WorkflowsV2ListWorkflowsWithResponse(ctx)
+	if err == nil && workflows.StatusCode() >= 400 {
+		err = clientError(workflows.StatusCode(), workflows.Body)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "listing workflows")
+	}
+	for _, workflow := range workflows.JSON200.Workflows {
+		result, err := workflowsClient.WorkflowsV2ShowWorkflowWithResponse(ctx, workflow.Id)
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading workflow %s", workflow.Id)
+		}
+
+		snapshot.Workflows = append(snapshot.Workflows, result.JSON200.Workflow)
+	}
+
+	return snapshot, nil
+}
+
+// withoutVolatileFields strips configSnapshotVolatileFields from a decoded snapshot, at
+// any depth.
+func withoutVolatileFields(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, nested := range value {
+			if lo.Contains(configSnapshotVolatileFields, key) {
+				continue
+			}
+			result[key] = withoutVolatileFields(nested)
+		}
+
+		return result
+	case []any:
+		return lo.Map(value, func(nested any, _ int) any {
+			return withoutVolatileFields(nested)
+		})
+	default:
+		return value
+	}
+}
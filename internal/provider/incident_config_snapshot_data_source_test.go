@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestBuildConfigSnapshotModel(t *testing.T) {
+	snapshot := func(name, onCallUserID string, updatedAt time.Time) *configSnapshot {
+		return &configSnapshot{
+			CatalogTypes: []client.CatalogTypeV2{
+				{Id: "01GW2G3V0S59R238FAHPDS1R66", Name: "Service", EstimatedCount: lo.ToPtr(int64(len(onCallUserID))), LastSyncedAt: &updatedAt, UpdatedAt: updatedAt},
+			},
+			Schedules: []client.ScheduleV2{
+				{
+					Id:       "01HPFH8T92MPGSQS5C1SPAF4V0",
+					Name:     name,
+					Timezone: "Europe/London",
+					CurrentShifts: &[]client.ScheduleEntryV2{
+						{User: &client.UserV1{Id: onCallUserID}, StartAt: updatedAt, EndAt: updatedAt.Add(time.Hour)},
+					},
+					UpdatedAt: updatedAt,
+				},
+			},
+		}
+	}
+
+	build := func(snapshot *configSnapshot) *IncidentConfigSnapshotDataSourceModel {
+		t.Helper()
+		model, err := buildConfigSnapshotModel(snapshot)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return model
+	}
+
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	original := build(snapshot("Primary", "01FCQSP07Z74QMMYPDDGQB9FTG", now))
+
+	for _, field := range configSnapshotVolatileFields {
+		if strings.Contains(original.JSON.ValueString(), field) {
+			t.Errorf("expected %s to be left out of the snapshot, got: %s", field, original.JSON.ValueString())
+		}
+	}
+
+	// A handover changes who's on call, but not the configuration.
+	handover := build(snapshot("Primary", "01G0J1EXE7AXZ2C93K61WBPYEH", now.Add(time.Hour)))
+	if handover.ID != original.ID {
+		t.Errorf("expected a shift change to keep the id %s, got %s", original.ID, handover.ID)
+	}
+
+	renamed := build(snapshot("Secondary", "01FCQSP07Z74QMMYPDDGQB9FTG", now))
+	if renamed.ID == original.ID {
+		t.Errorf("expected renaming a schedule to change the id")
+	}
+}
+
+func TestAccIncidentConfigSnapshotDataSource(t *testing.T) {
+	typeName := generateTypeName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentConfigSnapshotDataSourceConfig(configSnapshotDataSourceFixture{
+					TypeName: typeName,
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.incident_config_snapshot.snapshot", "id", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestMatchResourceAttr(
+						"data.incident_config_snapshot.snapshot", "json", regexp.MustCompile(regexp.QuoteMeta(typeName))),
+				),
+			},
+		},
+	})
+}
+
+var configSnapshotDataSourceTemplate = template.Must(template.New("incident_config_snapshot_data_source").Funcs(sprig.TxtFuncMap()).Parse(`
+resource "incident_catalog_type" "example" {
+  name        = "Snapshot"
+  type_name   = {{ quote .TypeName }}
+  description = "Used to test config snapshots."
+}
+
+data "incident_config_snapshot" "snapshot" {
+  depends_on = [incident_catalog_type.example]
+}
+`))
+
+type configSnapshotDataSourceFixture struct {
+	TypeName string
+}
+
+func testAccIncidentConfigSnapshotDataSourceConfig(payload configSnapshotDataSourceFixture) string {
+	var buf bytes.Buffer
+	if err := configSnapshotDataSourceTemplate.Execute(&buf, payload); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentConfigSnapshotDiffDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentConfigSnapshotDiffDataSource{}
+)
+
+func NewIncidentConfigSnapshotDiffDataSource() datasource.DataSource {
+	return &IncidentConfigSnapshotDiffDataSource{}
+}
+
+type IncidentConfigSnapshotDiffDataSource struct {
+	providerData *IncidentProviderData
+}
+
+type IncidentConfigSnapshotDiffDataSourceModel struct {
+	SourceJSON  types.String               `tfsdk:"source_json"`
+	Differences []ConfigSnapshotDifference `tfsdk:"differences"`
+}
+
+type ConfigSnapshotDifference struct {
+	ResourceType  types.String `tfsdk:"resource_type"`
+	Name          types.String `tfsdk:"name"`
+	Change        types.String `tfsdk:"change"`
+	ChangedFields types.List   `tfsdk:"changed_fields"`
+}
+
+const (
+	configSnapshotOnlyInSource  = "only_in_source"
+	configSnapshotOnlyInCurrent = "only_in_current"
+	configSnapshotChanged       = "changed"
+)
+
+func (i *IncidentConfigSnapshotDiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_snapshot_diff"
+}
+
+func (i *IncidentConfigSnapshotDiffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This data source compares a snapshot of another organisation's configuration, taken
+with ` + "`incident_config_snapshot`" + ` through an aliased provider, against the
+organisation this provider is configured for. Use it to review what would change when
+promoting configuration from one environment to another.
+
+IDs differ between organisations, so objects are matched by type and name, and any
+IDs, timestamps and annotations are ignored when comparing them. This means changes
+to which object another refers to (such as the catalog type of a custom field) aren't
+reported.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"source_json": schema.StringAttribute{
+				MarkdownDescription: "The `json` of an `incident_config_snapshot` from the organisation to compare against.",
+				Required:            true,
+			},
+			"differences": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects that differ between the two organisations, ordered by type and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the object, such as `catalog_type` or `severity`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the object. Custom field options are named `<custom field>: <value>`.",
+							Computed:            true,
+						},
+						"change": schema.StringAttribute{
+							MarkdownDescription: "One of `only_in_source`, `only_in_current` or `changed`.",
+							Computed:            true,
+						},
+						"changed_fields": schema.ListAttribute{
+							MarkdownDescription: "For changed objects, the top-level fields that differ.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (i *IncidentConfigSnapshotDiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.providerData = client
+}
+
+func (i *IncidentConfigSnapshotDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentConfigSnapshotDiffDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source configSnapshot
+	if err := json.Unmarshal([]byte(data.SourceJSON.ValueString()), &source); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_json"), "Invalid config snapshot",
+			fmt.Sprintf("Unable to parse source_json as a config snapshot, got error: %s", err))
+		return
+	}
+
+	current, err := loadConfigSnapshot(ctx, i.providerData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to build config snapshot, got error: %s", err))
+		return
+	}
+
+	data.Differences, err = diffConfigSnapshots(&source, current)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to compare config snapshots, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// diffConfigSnapshots compares every object in the two snapshots, matching them by
+// type and name.
+func diffConfigSnapshots(source, current *configSnapshot) ([]ConfigSnapshotDifference, error) {
+	sourceObjects, err := configSnapshotObjects(source)
+	if err != nil {
+		return nil, err
+	}
+	currentObjects, err := configSnapshotObjects(current)
+	if err != nil {
+		return nil, err
+	}
+
+	differences := []ConfigSnapshotDifference{}
+	for _, resourceType := range lo.Uniq(append(lo.Keys(sourceObjects), lo.Keys(currentObjects)...)) {
+		sourceByName, currentByName := sourceObjects[resourceType], currentObjects[resourceType]
+
+		for _, name := range lo.Uniq(append(lo.Keys(sourceByName), lo.Keys(currentByName)...)) {
+			sourceObject, inSource := sourceByName[name]
+			currentObject, inCurrent := currentByName[name]
+
+			difference := ConfigSnapshotDifference{
+				ResourceType:  types.StringValue(resourceType),
+				Name:          types.StringValue(name),
+				ChangedFields: types.ListNull(types.StringType),
+			}
+			switch {
+			case !inCurrent:
+				difference.Change = types.StringValue(configSnapshotOnlyInSource)
+			case !inSource:
+				difference.Change = types.StringValue(configSnapshotOnlyInCurrent)
+			default:
+				changedFields := lo.Filter(lo.Uniq(append(lo.Keys(sourceObject), lo.Keys(currentObject)...)), func(field string, _ int) bool {
+					return !reflect.DeepEqual(sourceObject[field], currentObject[field])
+				})
+				if len(changedFields) == 0 {
+					continue
+				}
+				sort.Strings(changedFields)
+
+				difference.Change = types.StringValue(configSnapshotChanged)
+				difference.ChangedFields = stringListValue(changedFields)
+			}
+
+			differences = append(differences, difference)
+		}
+	}
+
+	sort.Slice(differences, func(i, j int) bool {
+		if differences[i].ResourceType.ValueString() != differences[j].ResourceType.ValueString() {
+			return differences[i].ResourceType.ValueString() < differences[j].ResourceType.ValueString()
+		}
+		return differences[i].Name.ValueString() < differences[j].Name.ValueString()
+	})
+
+	return differences, nil
+}
+
+// configSnapshotObjects flattens a snapshot into comparable objects, keyed by type and
+// then by name, with anything specific to the organisation removed.
+func configSnapshotObjects(snapshot *configSnapshot) (map[string]map[string]map[string]any, error) {
+	customFieldNames := lo.SliceToMap(snapshot.CustomFields, func(customField client.CustomFieldV2) (string, string) {
+		return customField.Id, customField.Name
+	})
+
+	options := []client.CustomFieldOptionV1{}
+	for _, customFieldOptions := range snapshot.CustomFieldOptions {
+		options = append(options, customFieldOptions...)
+	}
+
+	objects := map[string]map[string]map[string]any{}
+	for resourceType, values := range map[string]any{
+		"catalog_type":        snapshot.CatalogTypes,
+		"custom_field":        snapshot.CustomFields,
+		"custom_field_option": options,
+		"incident_role":       snapshot.IncidentRoles,
+		"incident_status":     snapshot.IncidentStatuses,
+		"incident_type":       snapshot.IncidentTypes,
+		"schedule":            snapshot.Schedules,
+		"severity":            snapshot.Severities,
+		"workflow":            snapshot.Workflows,
+	} {
+		payload, err := json.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+
+		var list []map[string]any
+		if err := json.Unmarshal(payload, &list); err != nil {
+			return nil, err
+		}
+
+		objects[resourceType] = map[string]map[string]any{}
+		for _, object := range list {
+			name, _ := object["name"].(string)
+			if resourceType == "custom_field_option" {
+				customFieldID, _ := object["custom_field_id"].(string)
+				value, _ := object["value"].(string)
+				name = fmt.Sprintf("%s: %s", customFieldNames[customFieldID], value)
+			}
+
+			// Names aren't always unique, so number any duplicates in the order the API
+			// returned them.
+			key := name
+			for count := 2; objects[resourceType][key] != nil; count++ {
+				key = fmt.Sprintf("%s (%d)", name, count)
+			}
+
+			objects[resourceType][key] = withoutOrganisationFields(object).(map[string]any)
+		}
+	}
+
+	return objects, nil
+}
+
+// withoutOrganisationFields strips IDs, timestamps, annotations and versions from a
+// decoded object, as they'll always differ between organisations, along with the
+// volatile fields we leave out of snapshots.
+func withoutOrganisationFields(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, nested := range value {
+			switch {
+			case key == "id", key == "created_at", key == "annotations", key == "version":
+				continue
+			case lo.Contains(configSnapshotVolatileFields, key):
+				continue
+			case strings.HasSuffix(key, "_id"), strings.HasSuffix(key, "_ids"):
+				continue
+			}
+			result[key] = withoutOrganisationFields(nested)
+		}
+
+		return result
+	case []any:
+		return lo.Map(value, func(nested any, _ int) any {
+			return withoutOrganisationFields(nested)
+		})
+	default:
+		return value
+	}
+}
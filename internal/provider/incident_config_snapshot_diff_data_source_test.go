@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestDiffConfigSnapshots(t *testing.T) {
+	source := &configSnapshot{
+		CustomFields: []client.CustomFieldV2{
+			{Id: "01GW2G3V0S59R238FAHPDS1R66", Name: "Product area", Description: "Affected area", FieldType: "single_select"},
+		},
+		CustomFieldOptions: map[string][]client.CustomFieldOptionV1{
+			"01GW2G3V0S59R238FAHPDS1R66": {
+				{Id: "01GW2G3V0S59R238FAHPDS1R67", CustomFieldId: "01GW2G3V0S59R238FAHPDS1R66", Value: "Billing", SortKey: 10},
+			},
+		},
+		CatalogTypes: []client.CatalogTypeV2{
+			{Id: "01GW2G3V0S59R238FAHPDS1R68", Name: "Service", TypeName: "Custom[\"Service\"]", EstimatedCount: lo.ToPtr(int64(12)), LastSyncedAt: lo.ToPtr(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))},
+		},
+		Schedules: []client.ScheduleV2{
+			{
+				Id:       "01HPFH8T92MPGSQS5C1SPAF4V0",
+				Name:     "Primary",
+				Timezone: "Europe/London",
+				CurrentShifts: &[]client.ScheduleEntryV2{
+					{User: &client.UserV1{Id: "01FCQSP07Z74QMMYPDDGQB9FTG"}},
+				},
+				UpdatedAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		Severities: []client.SeverityV2{
+			{Id: "01FCNDV6P870EA6S7TK1DSYDG0", Name: "Minor", Description: "Issues with low impact", Rank: 1},
+			{Id: "01FCNDV6P870EA6S7TK1DSYDG1", Name: "Major", Description: "Issues with high impact", Rank: 2},
+		},
+	}
+	current := &configSnapshot{
+		CustomFields: []client.CustomFieldV2{
+			{Id: "01H0000000000000000000000A", Name: "Product area", Description: "Affected area", FieldType: "single_select"},
+		},
+		CustomFieldOptions: map[string][]client.CustomFieldOptionV1{
+			"01H0000000000000000000000A": {
+				{Id: "01H0000000000000000000000B", CustomFieldId: "01H0000000000000000000000A", Value: "Billing", SortKey: 10},
+			},
+		},
+		CatalogTypes: []client.CatalogTypeV2{
+			{Id: "01H0000000000000000000000E", Name: "Service", TypeName: "Custom[\"Service\"]", EstimatedCount: lo.ToPtr(int64(40))},
+		},
+		Schedules: []client.ScheduleV2{
+			{
+				Id:       "01H0000000000000000000000F",
+				Name:     "Primary",
+				Timezone: "Europe/London",
+				CurrentShifts: &[]client.ScheduleEntryV2{
+					{User: &client.UserV1{Id: "01H0000000000000000000000G"}},
+				},
+				UpdatedAt: time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		Severities: []client.SeverityV2{
+			{Id: "01H0000000000000000000000C", Name: "Minor", Description: "Issues with minimal impact", Rank: 1},
+			{Id: "01H0000000000000000000000D", Name: "Critical", Description: "Issues with severe impact", Rank: 3},
+		},
+	}
+
+	differences, err := diffConfigSnapshots(source, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type difference struct {
+		resourceType, name, change string
+		changedFields              []string
+	}
+	got := []difference{}
+	for _, diff := range differences {
+		var changedFields []string
+		if !diff.ChangedFields.IsNull() {
+			for _, field := range diff.ChangedFields.Elements() {
+				changedFields = append(changedFields, field.(types.String).ValueString())
+			}
+		}
+		got = append(got, difference{diff.ResourceType.ValueString(), diff.Name.ValueString(), diff.Change.ValueString(), changedFields})
+	}
+
+	// Objects that only differ by ID, such as the custom field and its option, or by
+	// volatile fields, such as who is on call for the schedule, aren't reported.
+	expected := []difference{
+		{"severity", "Critical", configSnapshotOnlyInCurrent, nil},
+		{"severity", "Major", configSnapshotOnlyInSource, nil},
+		{"severity", "Minor", configSnapshotChanged, []string{"description"}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestAccIncidentConfigSnapshotDiffDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Comparing an organisation against itself should find nothing.
+				Config: `
+data "incident_config_snapshot" "snapshot" {}
+
+data "incident_config_snapshot_diff" "diff" {
+  source_json = data.incident_config_snapshot.snapshot.json
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.incident_config_snapshot_diff.diff", "differences.#", "0"),
+				),
+			},
+		},
+	})
+}
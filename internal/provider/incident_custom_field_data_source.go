@@ -63,7 +63,7 @@ func (i *IncidentCustomFieldDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	i.client = client.Client
+	i.client = client.ClientFor(apiKeyFamilyCustomFields)
 }
 
 func (i *IncidentCustomFieldDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -79,7 +79,7 @@ func (i *IncidentCustomFieldDataSource) Read(ctx context.Context, req datasource
 
 	result, err := i.client.CustomFieldsV2ListWithResponse(ctx)
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom fields, got error: %s", err))
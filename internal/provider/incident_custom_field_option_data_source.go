@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/incident-io/terraform-provider-incident/internal/apischema"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
 	"github.com/samber/lo"
@@ -24,9 +25,16 @@ type IncidentCustomFieldOptionDataSource struct {
 	client *client.ClientWithResponses
 }
 
+type IncidentCustomFieldOptionDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	CustomFieldID types.String `tfsdk:"custom_field_id"`
+	SortKey       types.Int64  `tfsdk:"sort_key"`
+	Value         types.String `tfsdk:"value"`
+}
+
 func (i *IncidentCustomFieldOptionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "This data source provides information about a custom field option.",
+		MarkdownDescription: "This data source provides information about a custom field option, looked up by its `value` within a custom field. Use it to reference options in workflow conditions and default values without hardcoding their IDs.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: apischema.Docstring("CustomFieldOptionV1ResponseBody", "id"),
@@ -43,6 +51,7 @@ func (i *IncidentCustomFieldOptionDataSource) Schema(ctx context.Context, req da
 			"sort_key": schema.Int64Attribute{
 				MarkdownDescription: apischema.Docstring("CustomFieldOptionV1ResponseBody", "sort_key"),
 				Optional:            true,
+				Computed:            true,
 			},
 		},
 	}
@@ -63,7 +72,7 @@ func (i *IncidentCustomFieldOptionDataSource) Configure(ctx context.Context, req
 		return
 	}
 
-	i.client = client.Client
+	i.client = client.ClientFor(apiKeyFamilyCustomFields)
 }
 
 func (i *IncidentCustomFieldOptionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -71,7 +80,7 @@ func (i *IncidentCustomFieldOptionDataSource) Metadata(ctx context.Context, req
 }
 
 func (i *IncidentCustomFieldOptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data IncidentCustomFieldOptionResourceModel
+	var data IncidentCustomFieldOptionDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -86,33 +95,37 @@ func (i *IncidentCustomFieldOptionDataSource) Read(ctx context.Context, req data
 		return
 	}
 
-	result, err := i.client.CustomFieldOptionsV1ListWithResponse(ctx, &client.CustomFieldOptionsV1ListParams{
-		CustomFieldId: data.CustomFieldID.ValueString(),
-	})
-	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
-	}
+	customFieldOption, found, err := findCustomFieldOption(ctx, i.client, data.CustomFieldID.ValueString(), data.Value.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field options, got error: %s", err))
 		return
 	}
-
-	customFieldOptions := result.JSON200.CustomFieldOptions
-	customFieldOptions = lo.Filter(customFieldOptions, func(ct client.CustomFieldOptionV1, _ int) bool {
-		return ct.Value == data.Value.ValueString()
-	})
-
-	var customFieldOption *client.CustomFieldOptionV1
-	if len(customFieldOptions) > 0 {
-		customFieldOption = lo.ToPtr(customFieldOptions[0])
-	}
-
-	if customFieldOption == nil {
+	if !found {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find custom field option, got error: %s", "Custom field option not found"))
 		return
 	}
 
-	modelResp := new(IncidentCustomFieldOptionResource).buildModel(*customFieldOption)
+	modelResp := &IncidentCustomFieldOptionDataSourceModel{
+		ID:            types.StringValue(customFieldOption.Id),
+		CustomFieldID: types.StringValue(customFieldOption.CustomFieldId),
+		SortKey:       types.Int64Value(customFieldOption.SortKey),
+		Value:         types.StringValue(customFieldOption.Value),
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
 }
+
+// findCustomFieldOption looks up an option by value, searching every page of the
+// field's options as fields can have more than fit in a single page.
+func findCustomFieldOption(ctx context.Context, apiClient *client.ClientWithResponses, customFieldID, value string) (client.CustomFieldOptionV1, bool, error) {
+	options, err := listCustomFieldOptions(ctx, apiClient, customFieldID)
+	if err != nil {
+		return client.CustomFieldOptionV1{}, false, err
+	}
+
+	option, found := lo.Find(options, func(option client.CustomFieldOptionV1) bool {
+		return option.Value == value
+	})
+
+	return option, found, nil
+}
@@ -2,13 +2,84 @@ package provider
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"text/template"
 
 	"github.com/Masterminds/sprig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
 )
 
+func TestFindCustomFieldOption(t *testing.T) {
+	// A full first page, so the option we want is only on the second.
+	firstPage := make([]client.CustomFieldOptionV1, customFieldOptionsPageSize)
+	for idx := range firstPage {
+		firstPage[idx] = client.CustomFieldOptionV1{
+			Id:            fmt.Sprintf("01OPTION%03d", idx),
+			CustomFieldId: "01FIELD",
+			SortKey:       int64(idx),
+			Value:         fmt.Sprintf("Team %d", idx),
+		}
+	}
+	pages := map[string]client.ListResponseBody3{
+		"": {
+			CustomFieldOptions: firstPage,
+			PaginationMeta:     client.PaginationMetaResult{After: &firstPage[len(firstPage)-1].Id, PageSize: customFieldOptionsPageSize},
+		},
+		firstPage[len(firstPage)-1].Id: {
+			CustomFieldOptions: []client.CustomFieldOptionV1{
+				{Id: "01PAYMENTS", CustomFieldId: "01FIELD", SortKey: 1000, Value: "Payments"},
+			},
+			PaginationMeta: client.PaginationMetaResult{PageSize: customFieldOptionsPageSize},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Query().Get("after")]
+		if !ok {
+			t.Errorf("unexpected request: %s", r.URL)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(page); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		value         string
+		expectedID    string
+		expectedFound bool
+	}{
+		{value: "Team 3", expectedID: "01OPTION003", expectedFound: true},
+		{value: "Payments", expectedID: "01PAYMENTS", expectedFound: true},
+		{value: "Marketing", expectedFound: false},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			option, found, err := findCustomFieldOption(context.Background(), apiClient, "01FIELD", tc.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if found != tc.expectedFound || option.Id != tc.expectedID {
+				t.Errorf("expected %q (found=%v), got %q (found=%v)", tc.expectedID, tc.expectedFound, option.Id, found)
+			}
+		})
+	}
+}
+
 func TestAccIncidentCustomFieldOptionDataSource(t *testing.T) {
 	// Searching by value and custom_field_type
 	resource.Test(t, resource.TestCase{
@@ -17,8 +17,9 @@ import (
 )
 
 var (
-	_ resource.Resource                = &IncidentCustomFieldOptionResource{}
-	_ resource.ResourceWithImportState = &IncidentCustomFieldOptionResource{}
+	_ resource.Resource                   = &IncidentCustomFieldOptionResource{}
+	_ resource.ResourceWithImportState    = &IncidentCustomFieldOptionResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentCustomFieldOptionResource{}
 )
 
 type IncidentCustomFieldOptionResource struct {
@@ -26,10 +27,20 @@ type IncidentCustomFieldOptionResource struct {
 }
 
 type IncidentCustomFieldOptionResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	CustomFieldID types.String `tfsdk:"custom_field_id"`
-	SortKey       types.Int64  `tfsdk:"sort_key"`
-	Value         types.String `tfsdk:"value"`
+	ID             types.String `tfsdk:"id"`
+	CustomFieldID  types.String `tfsdk:"custom_field_id"`
+	SortKey        types.Int64  `tfsdk:"sort_key"`
+	Value          types.String `tfsdk:"value"`
+	SensitiveValue types.String `tfsdk:"sensitive_value"`
+}
+
+// optionValue returns whichever of value or sensitive_value has been set.
+func (m IncidentCustomFieldOptionResourceModel) optionValue() string {
+	if !m.SensitiveValue.IsNull() {
+		return m.SensitiveValue.ValueString()
+	}
+
+	return m.Value.ValueString()
 }
 
 func NewIncidentCustomFieldOptionResource() resource.Resource {
@@ -64,13 +75,41 @@ func (r *IncidentCustomFieldOptionResource) Schema(ctx context.Context, req reso
 				Computed:            true,
 			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: apischema.Docstring("CustomFieldOptionsV1CreateRequestBody", "value"),
-				Required:            true,
+				MarkdownDescription: apischema.Docstring("CustomFieldOptionsV1CreateRequestBody", "value") + ". Exactly one of `value` or `sensitive_value` must be set.",
+				Optional:            true,
+			},
+			"sensitive_value": schema.StringAttribute{
+				MarkdownDescription: "The value of the option, as with `value`, but marked as sensitive so it isn't shown in plan output. Use this for options that encode information such as customer names.",
+				Optional:            true,
+				Sensitive:           true,
 			},
 		},
 	}
 }
 
+func (r *IncidentCustomFieldOptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *IncidentCustomFieldOptionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Value.IsNull() && !data.SensitiveValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sensitive_value"),
+			"Conflicting option values",
+			"Only one of value or sensitive_value can be set.",
+		)
+	}
+	if data.Value.IsNull() && data.SensitiveValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Missing option value",
+			"One of value or sensitive_value must be set.",
+		)
+	}
+}
+
 func (r *IncidentCustomFieldOptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -86,7 +125,7 @@ func (r *IncidentCustomFieldOptionResource) Configure(ctx context.Context, req r
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyCustomFields)
 }
 
 func (r *IncidentCustomFieldOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -103,10 +142,10 @@ func (r *IncidentCustomFieldOptionResource) Create(ctx context.Context, req reso
 	result, err := r.client.CustomFieldOptionsV1CreateWithResponse(ctx, client.CustomFieldOptionsV1CreateJSONRequestBody{
 		CustomFieldId: data.CustomFieldID.ValueString(),
 		SortKey:       sortKey,
-		Value:         data.Value.ValueString(),
+		Value:         data.optionValue(),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field option, got error: %s", err))
@@ -114,7 +153,7 @@ func (r *IncidentCustomFieldOptionResource) Create(ctx context.Context, req reso
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a custom field option resource with id=%s", result.JSON201.CustomFieldOption.Id))
-	data = r.buildModel(result.JSON201.CustomFieldOption)
+	data = r.buildModel(result.JSON201.CustomFieldOption, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -137,7 +176,12 @@ func (r *IncidentCustomFieldOptionResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CustomFieldOption)
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field option, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.CustomFieldOption, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -150,17 +194,17 @@ func (r *IncidentCustomFieldOptionResource) Update(ctx context.Context, req reso
 
 	result, err := r.client.CustomFieldOptionsV1UpdateWithResponse(ctx, data.ID.ValueString(), client.CustomFieldOptionsV1UpdateJSONRequestBody{
 		SortKey: data.SortKey.ValueInt64(),
-		Value:   data.Value.ValueString(),
+		Value:   data.optionValue(),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CustomFieldOption)
+	data = r.buildModel(result.JSON200.CustomFieldOption, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -171,7 +215,10 @@ func (r *IncidentCustomFieldOptionResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	_, err := r.client.CustomFieldOptionsV1DeleteWithResponse(ctx, data.ID.ValueString())
+	result, err := r.client.CustomFieldOptionsV1DeleteWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field option, got error: %s", err))
 		return
@@ -182,11 +229,20 @@ func (r *IncidentCustomFieldOptionResource) ImportState(ctx context.Context, req
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *IncidentCustomFieldOptionResource) buildModel(option client.CustomFieldOptionV1) *IncidentCustomFieldOptionResourceModel {
-	return &IncidentCustomFieldOptionResourceModel{
-		ID:            types.StringValue(option.Id),
-		CustomFieldID: types.StringValue(option.CustomFieldId),
-		SortKey:       types.Int64Value(option.SortKey),
-		Value:         types.StringValue(option.Value),
+// buildModel generates a terraform model from the API response, putting the value in
+// sensitive_value if that's what the previous model used.
+func (r *IncidentCustomFieldOptionResource) buildModel(option client.CustomFieldOptionV1, previous *IncidentCustomFieldOptionResourceModel) *IncidentCustomFieldOptionResourceModel {
+	model := &IncidentCustomFieldOptionResourceModel{
+		ID:             types.StringValue(option.Id),
+		CustomFieldID:  types.StringValue(option.CustomFieldId),
+		SortKey:        types.Int64Value(option.SortKey),
+		Value:          types.StringValue(option.Value),
+		SensitiveValue: types.StringNull(),
+	}
+	if previous != nil && !previous.SensitiveValue.IsNull() {
+		model.Value = types.StringNull()
+		model.SensitiveValue = types.StringValue(option.Value)
 	}
+
+	return model
 }
@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+var (
+	_ resource.Resource                = &IncidentCustomFieldOptionsResource{}
+	_ resource.ResourceWithImportState = &IncidentCustomFieldOptionsResource{}
+	_ planmodifier.String              = customFieldOptionIDFromState{}
+)
+
+// customFieldOptionSortKeyStep is the gap we leave between the sort keys of
+// consecutive options, so options added in the dashboard can slot in between.
+const customFieldOptionSortKeyStep = 10
+
+// customFieldOptionsPageSize is the number of options we ask for in each page when
+// listing them.
+const customFieldOptionsPageSize = int64(250)
+
+type IncidentCustomFieldOptionsResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentCustomFieldOptionsResourceModel struct {
+	ID            types.String                   `tfsdk:"id"` // Custom Field ID
+	CustomFieldID types.String                   `tfsdk:"custom_field_id"`
+	Options       []CustomFieldOptionsEntryModel `tfsdk:"options"`
+}
+
+type CustomFieldOptionsEntryModel struct {
+	ID    types.String `tfsdk:"id"`
+	Value types.String `tfsdk:"value"`
+}
+
+func NewIncidentCustomFieldOptionsResource() resource.Resource {
+	return &IncidentCustomFieldOptionsResource{}
+}
+
+func (r *IncidentCustomFieldOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field_options"
+}
+
+func (r *IncidentCustomFieldOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This resource manages all options for a single- or multi-select custom field, in the
+order they should be displayed.
+
+Please note that this resource is authoritative, in that it will delete _all_ options
+of the custom field that aren't in the list, even those created outside of Terraform.
+
+Options are matched by their value, so changing the value of an option will delete it
+and create a new one in its place. This should not be used alongside
+` + "`incident_custom_field_option`" + ` resources for the same custom field.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CustomFieldOptionsV1CreateRequestBody", "custom_field_id"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_field_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("CustomFieldOptionsV1CreateRequestBody", "custom_field_id"),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.ListNestedAttribute{
+				MarkdownDescription: `The options of the custom field, in the order they should be displayed.`,
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CustomFieldOptionV1ResponseBody", "id"),
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								customFieldOptionIDFromState{},
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CustomFieldOptionsV1CreateRequestBody", "value"),
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *IncidentCustomFieldOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.ClientFor(apiKeyFamilyCustomFields)
+}
+
+func (r *IncidentCustomFieldOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentCustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := r.reconcile(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	data = r.buildModel(data.CustomFieldID.ValueString(), options)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentCustomFieldOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentCustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := listCustomFieldOptions(ctx, r.client, data.CustomFieldID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom field options, got error: %s", err))
+		return
+	}
+
+	data = r.buildModel(data.CustomFieldID.ValueString(), options)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentCustomFieldOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentCustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := r.reconcile(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	data = r.buildModel(data.CustomFieldID.ValueString(), options)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentCustomFieldOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentCustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the custom field has already gone, so have its options.
+	field, err := r.client.CustomFieldsV2ShowWithResponse(ctx, data.CustomFieldID.ValueString())
+	if err == nil && field.StatusCode() == 404 {
+		tflog.Debug(ctx, fmt.Sprintf("custom field with id=%s not found, nothing to delete", data.CustomFieldID.ValueString()))
+		return
+	}
+
+	// Set options to an empty list.
+	data.Options = []CustomFieldOptionsEntryModel{}
+
+	options, err := r.reconcile(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if len(options) > 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("tried deleting all options but found %d for custom field id=%s", len(options), data.CustomFieldID.ValueString()))
+		return
+	}
+}
+
+func (r *IncidentCustomFieldOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("custom_field_id"), req, resp)
+}
+
+// buildModel generates a terraform model from the current list of all options, as
+// received from getOptions.
+func (r *IncidentCustomFieldOptionsResource) buildModel(customFieldID string, options []client.CustomFieldOptionV1) *IncidentCustomFieldOptionsResourceModel {
+	return &IncidentCustomFieldOptionsResourceModel{
+		ID:            types.StringValue(customFieldID),
+		CustomFieldID: types.StringValue(customFieldID),
+		Options: lo.Map(options, func(option client.CustomFieldOptionV1, _ int) CustomFieldOptionsEntryModel {
+			return CustomFieldOptionsEntryModel{
+				ID:    types.StringValue(option.Id),
+				Value: types.StringValue(option.Value),
+			}
+		}),
+	}
+}
+
+// listCustomFieldOptions loads all options for the custom field, following pagination,
+// ordered by their sort key.
+func listCustomFieldOptions(ctx context.Context, apiClient *client.ClientWithResponses, customFieldID string) ([]client.CustomFieldOptionV1, error) {
+	var (
+		after   *string
+		options []client.CustomFieldOptionV1
+	)
+
+	for {
+		result, err := apiClient.CustomFieldOptionsV1ListWithResponse(ctx, &client.CustomFieldOptionsV1ListParams{
+			CustomFieldId: customFieldID,
+			PageSize:      lo.ToPtr(customFieldOptionsPageSize),
+			After:         after,
+		})
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "listing custom field options")
+		}
+
+		page := result.JSON200.CustomFieldOptions
+		options = append(options, page...)
+
+		// A short page is the last one, so we don't need to ask for an empty page to know
+		// we're done.
+		after = result.JSON200.PaginationMeta.After
+		if after == nil || *after == "" || int64(len(page)) < customFieldOptionsPageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].SortKey < options[j].SortKey
+	})
+
+	return options, nil
+}
+
+// reconcile works in the same way as it does for catalog entries: we load all the
+// current options for the field and delete any that aren't in our model, before
+// creating or updating the rest so their sort keys match their position in the list.
+func (r *IncidentCustomFieldOptionsResource) reconcile(ctx context.Context, data *IncidentCustomFieldOptionsResourceModel) ([]client.CustomFieldOptionV1, error) {
+	customFieldID := data.CustomFieldID.ValueString()
+
+	wantValues := map[string]bool{}
+	for _, option := range data.Options {
+		if wantValues[option.Value.ValueString()] {
+			return nil, fmt.Errorf("option with value %q appears more than once, but values must be unique", option.Value.ValueString())
+		}
+		wantValues[option.Value.ValueString()] = true
+	}
+
+	options, err := listCustomFieldOptions(ctx, r.client, customFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	optionsByValue := map[string]client.CustomFieldOptionV1{}
+	for _, option := range options {
+		if !wantValues[option.Value] {
+			result, err := r.client.CustomFieldOptionsV1DeleteWithResponse(ctx, option.Id)
+			if err == nil && result.StatusCode() >= 400 {
+				err = clientError(result.StatusCode(), result.Body)
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("unable to delete custom field option with id=%s, got error", option.Id))
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("deleted custom field option with id=%s", option.Id))
+			continue
+		}
+
+		optionsByValue[option.Value] = option
+	}
+
+	for idx, option := range data.Options {
+		sortKey := int64((idx + 1) * customFieldOptionSortKeyStep)
+
+		existing, ok := optionsByValue[option.Value.ValueString()]
+		if !ok {
+			result, err := r.client.CustomFieldOptionsV1CreateWithResponse(ctx, client.CustomFieldOptionsV1CreateJSONRequestBody{
+				CustomFieldId: customFieldID,
+				SortKey:       lo.ToPtr(sortKey),
+				Value:         option.Value.ValueString(),
+			})
+			if err == nil && result.StatusCode() >= 400 {
+				err = clientError(result.StatusCode(), result.Body)
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("unable to create custom field option with value=%s, got error", option.Value.ValueString()))
+			}
+
+			tflog.Debug(ctx, fmt.Sprintf("created custom field option with id=%s", result.JSON201.CustomFieldOption.Id))
+			continue
+		}
+
+		if existing.SortKey == sortKey {
+			tflog.Debug(ctx, fmt.Sprintf("custom field option with id=%s has not changed, not updating", existing.Id))
+			continue
+		}
+
+		result, err := r.client.CustomFieldOptionsV1UpdateWithResponse(ctx, existing.Id, client.CustomFieldOptionsV1UpdateJSONRequestBody{
+			SortKey: sortKey,
+			Value:   existing.Value,
+		})
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("unable to update custom field option with id=%s, got error", existing.Id))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("updated custom field option with id=%s", existing.Id))
+	}
+
+	return listCustomFieldOptions(ctx, r.client, customFieldID)
+}
+
+// customFieldOptionIDFromState plans the ID of each option from state, matching
+// options by value, so reordering or adding options doesn't show every ID as changing.
+// Options that aren't in state yet are left unknown, as they get an ID when created.
+type customFieldOptionIDFromState struct{}
+
+func (m customFieldOptionIDFromState) Description(ctx context.Context) string {
+	return "Uses the ID of the option with the same value in state."
+}
+
+func (m customFieldOptionIDFromState) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m customFieldOptionIDFromState) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.PlanValue.IsUnknown() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var (
+		value types.String
+		state []CustomFieldOptionsEntryModel
+	)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("value"), &value)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("options"), &state)...)
+	if resp.Diagnostics.HasError() || value.IsUnknown() {
+		return
+	}
+
+	if option, ok := lo.Find(state, func(option CustomFieldOptionsEntryModel) bool {
+		return option.Value.Equal(value)
+	}); ok {
+		resp.PlanValue = option.ID
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestListCustomFieldOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		pages []string
+	}{
+		{
+			name: "single page without after",
+			pages: []string{
+				`{"custom_field_options": [{"id": "01B", "sort_key": 20}, {"id": "01A", "sort_key": 10}], "pagination_meta": {"page_size": 250}}`,
+			},
+		},
+		{
+			name: "short page with after",
+			pages: []string{
+				`{"custom_field_options": [{"id": "01B", "sort_key": 20}, {"id": "01A", "sort_key": 10}], "pagination_meta": {"page_size": 250, "after": "01A"}}`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests >= len(tc.pages) {
+					t.Errorf("unexpected request for page %d: %s", requests+1, r.URL)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tc.pages[requests])
+				requests++
+			}))
+			defer server.Close()
+
+			apiClient, err := client.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			options, err := listCustomFieldOptions(context.Background(), apiClient, "01FIELD")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if requests != len(tc.pages) {
+				t.Errorf("expected %d requests, got %d", len(tc.pages), requests)
+			}
+			if len(options) != 2 || options[0].Id != "01A" {
+				t.Errorf("expected both options ordered by sort key, got %v", options)
+			}
+		})
+	}
+}
+
+func TestAccIncidentCustomFieldOptionsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentCustomFieldOptionsResourceConfig([]string{
+					"Payments", "Dashboard", "API",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_custom_field_options.example", "options.#", "3"),
+					resource.TestCheckResourceAttr(
+						"incident_custom_field_options.example", "options.0.value", "Payments"),
+					resource.TestCheckResourceAttr(
+						"incident_custom_field_options.example", "options.2.value", "API"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_custom_field_options.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Reorder, remove and add options
+			{
+				Config: testAccIncidentCustomFieldOptionsResourceConfig([]string{
+					"API", "Payments", "On-call",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_custom_field_options.example", "options.#", "3"),
+					resource.TestCheckResourceAttr(
+						"incident_custom_field_options.example", "options.0.value", "API"),
+					resource.TestCheckResourceAttr(
+						"incident_custom_field_options.example", "options.1.value", "Payments"),
+					resource.TestCheckResourceAttr(
+						"incident_custom_field_options.example", "options.2.value", "On-call"),
+				),
+			},
+		},
+	})
+}
+
+var customFieldOptionsTemplate = template.Must(template.New("incident_custom_field_options").Funcs(sprig.TxtFuncMap()).Parse(`
+resource "incident_custom_field" "affected_teams" {
+  name        = "Affected Teams (Options)"
+  description = "The teams that are affected by this incident."
+  field_type  = "multi_select"
+}
+
+resource "incident_custom_field_options" "example" {
+  custom_field_id = incident_custom_field.affected_teams.id
+  options = [
+{{ range . }}    { value = {{ quote . }} },
+{{ end }}  ]
+}
+`))
+
+func testAccIncidentCustomFieldOptionsResourceConfig(values []string) string {
+	var buf bytes.Buffer
+	if err := customFieldOptionsTemplate.Execute(&buf, values); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
@@ -63,6 +63,7 @@ func (r *IncidentCustomFieldResource) Schema(ctx context.Context, req resource.S
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					customFieldTypeChangeWarning{},
 				},
 			},
 		},
@@ -84,7 +85,7 @@ func (r *IncidentCustomFieldResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyCustomFields)
 }
 
 func (r *IncidentCustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -100,7 +101,7 @@ func (r *IncidentCustomFieldResource) Create(ctx context.Context, req resource.C
 		FieldType:   client.CreateRequestBody3FieldType(data.FieldType.ValueString()),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field, got error: %s", err))
@@ -131,6 +132,11 @@ func (r *IncidentCustomFieldResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
 	data = r.buildModel(result.JSON200.CustomField)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -147,7 +153,7 @@ func (r *IncidentCustomFieldResource) Update(ctx context.Context, req resource.U
 		Description: data.Description.ValueString(),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field, got error: %s", err))
@@ -165,7 +171,10 @@ func (r *IncidentCustomFieldResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	_, err := r.client.CustomFieldsV2DeleteWithResponse(ctx, data.ID.ValueString())
+	result, err := r.client.CustomFieldsV2DeleteWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field, got error: %s", err))
 		return
@@ -184,3 +193,34 @@ func (r *IncidentCustomFieldResource) buildModel(cf client.CustomFieldV2) *Incid
 		FieldType:   types.StringValue(string(cf.FieldType)),
 	}
 }
+
+// customFieldTypeChangeWarning explains what happens when the type of an existing
+// custom field changes. The API can't convert a field between types, even between
+// single_select and multi_select, so the field is replaced and everything attached
+// to the old one is lost.
+type customFieldTypeChangeWarning struct{}
+
+func (m customFieldTypeChangeWarning) Description(ctx context.Context) string {
+	return "Warns that changing the type of a custom field replaces it, losing its values on existing incidents."
+}
+
+func (m customFieldTypeChangeWarning) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m customFieldTypeChangeWarning) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.StateValue.Equal(req.PlanValue) {
+		return
+	}
+
+	detail := fmt.Sprintf(
+		"Changing field_type from %s to %s deletes this custom field and creates a new one, as the API can't change the type of an existing field. "+
+			"Values of this field on existing incidents will be lost, and anything referencing the field's ID, such as workflows, will need to use the new ID.",
+		req.StateValue.ValueString(), req.PlanValue.ValueString(),
+	)
+	if req.StateValue.ValueString() == string(client.CreateRequestBody3FieldTypeSingleSelect) || req.StateValue.ValueString() == string(client.CreateRequestBody3FieldTypeMultiSelect) {
+		detail += " The field's options will also be deleted, so any incident_custom_field_option resources will be recreated against the new field."
+	}
+
+	resp.Diagnostics.AddAttributeWarning(req.Path, "Custom field will be replaced", detail)
+}
@@ -2,15 +2,46 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"reflect"
 	"testing"
 	"text/template"
 
 	"github.com/Masterminds/sprig"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
 )
 
+func TestCustomFieldTypeChangeWarning(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		state, plan types.String
+		warn        bool
+	}{
+		{"create", types.StringNull(), types.StringValue("text"), false},
+		{"unchanged", types.StringValue("text"), types.StringValue("text"), false},
+		{"unknown", types.StringValue("text"), types.StringUnknown(), false},
+		{"single to multi select", types.StringValue("single_select"), types.StringValue("multi_select"), true},
+		{"text to numeric", types.StringValue("text"), types.StringValue("numeric"), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &planmodifier.StringResponse{PlanValue: tc.plan}
+			customFieldTypeChangeWarning{}.PlanModifyString(context.Background(), planmodifier.StringRequest{
+				Path:       path.Root("field_type"),
+				StateValue: tc.state,
+				PlanValue:  tc.plan,
+			}, resp)
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.warn {
+				t.Errorf("expected warning = %v, got diagnostics: %v", tc.warn, resp.Diagnostics)
+			}
+		})
+	}
+}
+
 func TestAccIncidentCustomFieldResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentCustomFieldsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentCustomFieldsDataSource{}
+)
+
+func NewIncidentCustomFieldsDataSource() datasource.DataSource {
+	return &IncidentCustomFieldsDataSource{}
+}
+
+type IncidentCustomFieldsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentCustomFieldsDataSourceModel struct {
+	Limit        types.Int64                           `tfsdk:"limit"`
+	After        types.String                          `tfsdk:"after"`
+	TotalCount   types.Int64                           `tfsdk:"total_count"`
+	CustomFields []IncidentCustomFieldsDataSourceEntry `tfsdk:"custom_fields"`
+}
+
+type IncidentCustomFieldsDataSourceEntry struct {
+	ID            types.String                           `tfsdk:"id"`
+	Name          types.String                           `tfsdk:"name"`
+	Description   types.String                           `tfsdk:"description"`
+	FieldType     types.String                           `tfsdk:"field_type"`
+	CatalogTypeID types.String                           `tfsdk:"catalog_type_id"`
+	CreatedAt     types.String                           `tfsdk:"created_at"`
+	UpdatedAt     types.String                           `tfsdk:"updated_at"`
+	Options       []IncidentCustomFieldsDataSourceOption `tfsdk:"options"`
+}
+
+type IncidentCustomFieldsDataSourceOption struct {
+	ID      types.String `tfsdk:"id"`
+	Value   types.String `tfsdk:"value"`
+	SortKey types.Int64  `tfsdk:"sort_key"`
+}
+
+func (i *IncidentCustomFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_fields"
+}
+
+func (i *IncidentCustomFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists every custom field in your organisation, with the options of select fields. Use it to audit custom fields, such as checking they follow a naming convention, or finding fields that were created outside of Terraform.\n\nThis makes a request for the options of every select field, so can be slow in organisations with many custom fields. Set `limit` and `after` to load them a page at a time.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"custom_fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The custom fields, in the order the API returns them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CustomFieldV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CustomFieldV2ResponseBody", "name"),
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CustomFieldV2ResponseBody", "description"),
+							Computed:            true,
+						},
+						"field_type": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CustomFieldV2ResponseBody", "field_type"),
+							Computed:            true,
+						},
+						"catalog_type_id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("CustomFieldV2ResponseBody", "catalog_type_id"),
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the custom field was created, in RFC3339 format.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the custom field was last updated, in RFC3339 format.",
+							Computed:            true,
+						},
+						"options": schema.ListNestedAttribute{
+							MarkdownDescription: "The options of a single or multi select field, ordered by sort key. Empty for other types of field.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										MarkdownDescription: apischema.Docstring("CustomFieldOptionV1ResponseBody", "id"),
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: apischema.Docstring("CustomFieldOptionV1ResponseBody", "value"),
+										Computed:            true,
+									},
+									"sort_key": schema.Int64Attribute{
+										MarkdownDescription: apischema.Docstring("CustomFieldOptionV1ResponseBody", "sort_key"),
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentCustomFieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyCustomFields)
+}
+
+func (i *IncidentCustomFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentCustomFieldsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.CustomFieldsV2ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list custom fields, got error: %s", err))
+		return
+	}
+
+	data.TotalCount = types.Int64Value(int64(len(result.JSON200.CustomFields)))
+
+	// Paginate before loading options, so we only load them for the fields we return.
+	customFields := paginateSlice(result.JSON200.CustomFields, func(customField client.CustomFieldV2) string {
+		return customField.Id
+	}, data.Limit, data.After)
+
+	for _, customField := range customFields {
+		var fieldOptions []client.CustomFieldOptionV1
+		if customFieldHasOptions(customField) {
+			fieldOptions, err = listCustomFieldOptions(ctx, i.client, customField.Id)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list options for custom field %s, got error: %s", customField.Name, err))
+				return
+			}
+		}
+
+		data.CustomFields = append(data.CustomFields, buildCustomFieldsDataSourceEntry(customField, fieldOptions))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// customFieldHasOptions returns true for fields whose values are chosen from a list
+// of options, which we need to load separately.
+func customFieldHasOptions(customField client.CustomFieldV2) bool {
+	return customField.FieldType == client.SingleSelect || customField.FieldType == client.MultiSelect
+}
+
+func buildCustomFieldsDataSourceEntry(customField client.CustomFieldV2, options []client.CustomFieldOptionV1) IncidentCustomFieldsDataSourceEntry {
+	model := new(IncidentCustomFieldResource).buildModel(customField)
+
+	return IncidentCustomFieldsDataSourceEntry{
+		ID:            model.ID,
+		Name:          model.Name,
+		Description:   model.Description,
+		FieldType:     model.FieldType,
+		CatalogTypeID: types.StringPointerValue(customField.CatalogTypeId),
+		CreatedAt:     types.StringValue(customField.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:     types.StringValue(customField.UpdatedAt.Format(time.RFC3339)),
+		Options: lo.Map(options, func(option client.CustomFieldOptionV1, _ int) IncidentCustomFieldsDataSourceOption {
+			return IncidentCustomFieldsDataSourceOption{
+				ID:      types.StringValue(option.Id),
+				Value:   types.StringValue(option.Value),
+				SortKey: types.Int64Value(option.SortKey),
+			}
+		}),
+	}
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentCustomFieldsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentCustomFieldOptionResourceConfig(nil) + `
+data "incident_custom_fields" "all" {
+  depends_on = [incident_custom_field_option.example]
+}
+
+data "incident_custom_fields" "first" {
+  limit = 1
+
+  depends_on = [incident_custom_field_option.example]
+}
+
+locals {
+  affected_teams = one([for field in data.incident_custom_fields.all.custom_fields : field if field.id == incident_custom_field.affected_teams.id])
+}
+
+output "affected_teams_field_type" {
+  value = local.affected_teams.field_type
+}
+
+output "affected_teams_options" {
+  value = join(",", [for option in local.affected_teams.options : option.value])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("affected_teams_field_type", "multi_select"),
+					resource.TestCheckOutput("affected_teams_options", "Payments"),
+					resource.TestCheckResourceAttr(
+						"data.incident_custom_fields.first", "custom_fields.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_custom_fields.first", "total_count",
+						"data.incident_custom_fields.all", "total_count"),
+				),
+			},
+		},
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,12 +21,14 @@ import (
 )
 
 var (
-	_ resource.Resource                = &IncidentEscalationPathResource{}
-	_ resource.ResourceWithImportState = &IncidentEscalationPathResource{}
+	_ resource.Resource                   = &IncidentEscalationPathResource{}
+	_ resource.ResourceWithImportState    = &IncidentEscalationPathResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentEscalationPathResource{}
 )
 
 type IncidentEscalationPathResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	dashboard *DashboardURL
 }
 
 type IncidentEscalationPathResourceModel struct {
@@ -32,6 +36,7 @@ type IncidentEscalationPathResourceModel struct {
 	Name         types.String                    `tfsdk:"name"`
 	Path         []IncidentEscalationPathNode    `tfsdk:"path"`
 	WorkingHours []IncidentWeekdayIntervalConfig `tfsdk:"working_hours"`
+	AppURL       types.String                    `tfsdk:"app_url"`
 }
 
 type IncidentEscalationPathNode struct {
@@ -78,6 +83,13 @@ func (r *IncidentEscalationPathResource) Schema(ctx context.Context, req resourc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: apischema.TagDocstring("Escalations V2"),
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this escalation path in the incident.io dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: apischema.Docstring("EscalationPathV2ResponseBody", "id"),
@@ -202,6 +214,150 @@ func (r *IncidentEscalationPathResource) getPathSchema(depth int) schema.NestedA
 	return result
 }
 
+func (r *IncidentEscalationPathResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var nodes types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("path"), &nodes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paged, diags := r.validatePath(nodes, path.Root("path"), nil, false)
+	resp.Diagnostics.Append(diags...)
+	if !diags.HasError() && !paged {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Escalation path may not page anyone",
+			"At least one route through this escalation path finishes without reaching a level with targets, so nobody would be paged.",
+		)
+	}
+}
+
+// validatePath checks the nodes of an escalation path, or one branch of it, before we
+// send them to the API, as mistakes are otherwise only found when nobody is paged. It
+// returns whether every route through the nodes reaches a level with targets, given
+// whether earlier nodes already have.
+//
+// We walk the config rather than decoding it into IncidentEscalationPathResourceModel,
+// as any part of it may be unknown until apply, such as targets built from a module
+// variable. Parts we don't know yet are given the benefit of the doubt.
+//
+// earlierNodeIDs are the IDs of the nodes we've already passed through, which are the
+// only nodes a repeat can go back to.
+func (r *IncidentEscalationPathResource) validatePath(nodes types.List, nodesPath path.Path, earlierNodeIDs []string, paged bool) (bool, diag.Diagnostics) {
+	if nodes.IsUnknown() {
+		return true, nil
+	}
+
+	var (
+		diags        diag.Diagnostics
+		previousType string
+	)
+	for idx, element := range nodes.Elements() {
+		nodePath := nodesPath.AtListIndex(idx)
+		if previousType == string(client.EscalationPathNodeV2TypeRepeat) {
+			diags.AddAttributeError(
+				nodePath,
+				"Unreachable escalation path node",
+				"Nodes after a repeat are never reached. Move them before the repeat, or remove them.",
+			)
+			break
+		}
+
+		node, _ := element.(types.Object)
+		if node.IsUnknown() {
+			previousType = ""
+			paged = true
+			continue
+		}
+		attributes := node.Attributes()
+
+		visibleNodeIDs := earlierNodeIDs
+		if id := objectAttribute[types.String](attributes, "id"); !id.IsNull() && !id.IsUnknown() {
+			earlierNodeIDs = append(append([]string{}, earlierNodeIDs...), id.ValueString())
+		}
+
+		nodeType := objectAttribute[types.String](attributes, "type")
+		previousType = nodeType.ValueString()
+		if nodeType.IsUnknown() {
+			paged = true
+			continue
+		}
+
+		switch nodeType.ValueString() {
+		case string(client.EscalationPathNodeV2TypeLevel):
+			level := objectAttribute[types.Object](attributes, "level")
+			if level.IsNull() {
+				diags.AddAttributeError(nodePath.AtName("level"), "Missing escalation path level", "Nodes of type level must set level.")
+				continue
+			}
+			targets := objectAttribute[types.List](level.Attributes(), "targets")
+			if !level.IsUnknown() && !targets.IsUnknown() && len(targets.Elements()) == 0 {
+				diags.AddAttributeError(
+					nodePath.AtName("level").AtName("targets"),
+					"Empty escalation path level",
+					"Levels must have at least one target, or nobody is paged when the path reaches them.",
+				)
+				continue
+			}
+			paged = true
+
+		case string(client.EscalationPathNodeV2TypeIfElse):
+			ifElse := objectAttribute[types.Object](attributes, "if_else")
+			if ifElse.IsNull() {
+				diags.AddAttributeError(nodePath.AtName("if_else"), "Missing escalation path branch", "Nodes of type if_else must set if_else.")
+				continue
+			}
+			if ifElse.IsUnknown() {
+				paged = true
+				continue
+			}
+			thenPaged, thenDiags := r.validatePath(objectAttribute[types.List](ifElse.Attributes(), "then_path"), nodePath.AtName("if_else").AtName("then_path"), earlierNodeIDs, paged)
+			elsePaged, elseDiags := r.validatePath(objectAttribute[types.List](ifElse.Attributes(), "else_path"), nodePath.AtName("if_else").AtName("else_path"), earlierNodeIDs, paged)
+			diags.Append(thenDiags...)
+			diags.Append(elseDiags...)
+			paged = thenPaged && elsePaged
+
+		case string(client.EscalationPathNodeV2TypeRepeat):
+			repeat := objectAttribute[types.Object](attributes, "repeat")
+			if repeat.IsNull() {
+				diags.AddAttributeError(nodePath.AtName("repeat"), "Missing escalation path repeat", "Nodes of type repeat must set repeat.")
+				continue
+			}
+			if times := objectAttribute[types.Int64](repeat.Attributes(), "repeat_times"); !times.IsNull() && !times.IsUnknown() && times.ValueInt64() < 1 {
+				diags.AddAttributeError(
+					nodePath.AtName("repeat").AtName("repeat_times"),
+					"Invalid escalation path repeat",
+					fmt.Sprintf("repeat_times must be at least 1, got: %d", times.ValueInt64()),
+				)
+			}
+			if toNode := objectAttribute[types.String](repeat.Attributes(), "to_node"); !toNode.IsNull() && !toNode.IsUnknown() && !lo.Contains(visibleNodeIDs, toNode.ValueString()) {
+				diags.AddAttributeError(
+					nodePath.AtName("repeat").AtName("to_node"),
+					"Invalid escalation path repeat",
+					fmt.Sprintf("to_node must be the id of a node before this repeat, or one containing it, got: %s", toNode.ValueString()),
+				)
+			}
+
+		default:
+			diags.AddAttributeError(
+				nodePath.AtName("type"),
+				"Unknown escalation path node type",
+				fmt.Sprintf("Expected one of level, if_else or repeat, got: %s", nodeType.ValueString()),
+			)
+		}
+	}
+
+	return paged, diags
+}
+
+// objectAttribute returns the named attribute of an object from config, or a null
+// value if the object doesn't have it, such as when the object itself is unknown.
+func objectAttribute[T attr.Value](attributes map[string]attr.Value, name string) T {
+	value, _ := attributes[name].(T)
+
+	return value
+}
+
 func (r *IncidentEscalationPathResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -217,7 +373,8 @@ func (r *IncidentEscalationPathResource) Configure(ctx context.Context, req reso
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyOnCall)
+	r.dashboard = client.DashboardURL
 }
 
 func (r *IncidentEscalationPathResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -241,7 +398,7 @@ func (r *IncidentEscalationPathResource) Create(ctx context.Context, req resourc
 		WorkingHours: workingHours,
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create escalation path, got error: %s", err))
@@ -250,6 +407,7 @@ func (r *IncidentEscalationPathResource) Create(ctx context.Context, req resourc
 
 	tflog.Trace(ctx, fmt.Sprintf("created an escalation path resource with id=%s", result.JSON201.EscalationPath.Id))
 	data = r.buildModel(result.JSON201.EscalationPath)
+	data.AppURL = r.dashboard.Build(ctx, "on-call", "escalation-paths", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -272,7 +430,13 @@ func (r *IncidentEscalationPathResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read escalation path, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
 	data = r.buildModel(result.JSON200.EscalationPath)
+	data.AppURL = r.dashboard.Build(ctx, "on-call", "escalation-paths", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -297,7 +461,7 @@ func (r *IncidentEscalationPathResource) Update(ctx context.Context, req resourc
 		WorkingHours: workingHours,
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update escalation path, got error: %s", err))
@@ -305,6 +469,7 @@ func (r *IncidentEscalationPathResource) Update(ctx context.Context, req resourc
 	}
 
 	data = r.buildModel(result.JSON200.EscalationPath)
+	data.AppURL = r.dashboard.Build(ctx, "on-call", "escalation-paths", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -315,7 +480,10 @@ func (r *IncidentEscalationPathResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
-	_, err := r.client.EscalationsV2DestroyPathWithResponse(ctx, data.ID.ValueString())
+	result, err := r.client.EscalationsV2DestroyPathWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete escalation path, got error: %s", err))
 		return
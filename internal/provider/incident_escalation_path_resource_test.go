@@ -2,11 +2,18 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"testing"
 	"text/template"
 
 	"github.com/Masterminds/sprig"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/samber/lo"
 )
 
 func TestAccIncidentEscalationPathResource(t *testing.T) {
@@ -74,6 +81,165 @@ func TestAccIncidentEscalationPathResource(t *testing.T) {
 	})
 }
 
+func TestIncidentEscalationPathResourceValidatePath(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp frameworkresource.SchemaResponse
+	new(IncidentEscalationPathResource).Schema(ctx, frameworkresource.SchemaRequest{}, &schemaResp)
+	pathType := schemaResp.Schema.Attributes["path"].GetType().(types.ListType)
+
+	// object builds an object as it would appear in config, with any attributes we don't
+	// set left null.
+	object := func(objectType types.ObjectType, values map[string]attr.Value) types.Object {
+		attributes := map[string]attr.Value{}
+		for name, attrType := range objectType.AttrTypes {
+			if value, ok := values[name]; ok {
+				attributes[name] = value
+				continue
+			}
+
+			value, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			attributes[name] = value
+		}
+
+		return types.ObjectValueMust(objectType.AttrTypes, attributes)
+	}
+
+	// Nodes are built from the type of the list they're in, as each level of nesting in
+	// the schema has its own type.
+	type node func(nodeType types.ObjectType) attr.Value
+	nodes := func(listType types.ListType, elements ...node) types.List {
+		nodeType := listType.ElemType.(types.ObjectType)
+
+		return types.ListValueMust(nodeType, lo.Map(elements, func(element node, _ int) attr.Value {
+			return element(nodeType)
+		}))
+	}
+	levelWithTargets := func(targets func(targetType attr.Type) types.List) node {
+		return func(nodeType types.ObjectType) attr.Value {
+			levelType := nodeType.AttrTypes["level"].(types.ObjectType)
+
+			return object(nodeType, map[string]attr.Value{
+				"type":  types.StringValue("level"),
+				"level": object(levelType, map[string]attr.Value{"targets": targets(levelType.AttrTypes["targets"].(types.ListType).ElemType)}),
+			})
+		}
+	}
+	level := func(targets int) node {
+		return levelWithTargets(func(targetType attr.Type) types.List {
+			return types.ListValueMust(targetType, lo.Times(targets, func(int) attr.Value {
+				return object(targetType.(types.ObjectType), map[string]attr.Value{
+					"id":      types.StringValue("01FCNDV6P870EA6S7TK1DSYDG0"),
+					"type":    types.StringValue("schedule"),
+					"urgency": types.StringValue("high"),
+				})
+			}))
+		})
+	}
+	repeat := func(times int64, toNode string) node {
+		return func(nodeType types.ObjectType) attr.Value {
+			return object(nodeType, map[string]attr.Value{
+				"type": types.StringValue("repeat"),
+				"repeat": object(nodeType.AttrTypes["repeat"].(types.ObjectType), map[string]attr.Value{
+					"repeat_times": types.Int64Value(times),
+					"to_node":      types.StringValue(toNode),
+				}),
+			})
+		}
+	}
+	ifElse := func(id string, thenPath, elsePath []node) node {
+		return func(nodeType types.ObjectType) attr.Value {
+			ifElseType := nodeType.AttrTypes["if_else"].(types.ObjectType)
+
+			return object(nodeType, map[string]attr.Value{
+				"id":   types.StringValue(id),
+				"type": types.StringValue("if_else"),
+				"if_else": object(ifElseType, map[string]attr.Value{
+					"then_path": nodes(ifElseType.AttrTypes["then_path"].(types.ListType), thenPath...),
+					"else_path": nodes(ifElseType.AttrTypes["else_path"].(types.ListType), elsePath...),
+				}),
+			})
+		}
+	}
+
+	for _, tc := range []struct {
+		name          string
+		nodes         []node
+		expectedPaged bool
+		expectedError string
+	}{
+		{
+			name: "valid path",
+			nodes: []node{
+				ifElse("start", []node{level(1), repeat(3, "start")}, []node{level(1)}),
+			},
+			expectedPaged: true,
+		},
+		{
+			name: "branch without a level",
+			nodes: []node{
+				ifElse("start", []node{level(1)}, nil),
+			},
+			expectedPaged: false,
+		},
+		{
+			name: "branch after a level",
+			nodes: []node{
+				level(1), ifElse("start", []node{level(1)}, nil),
+			},
+			expectedPaged: true,
+		},
+		{
+			name: "unknown targets",
+			nodes: []node{
+				ifElse("start", []node{levelWithTargets(types.ListUnknown)}, []node{level(1)}),
+			},
+			expectedPaged: true,
+		},
+		{
+			name:          "empty level",
+			nodes:         []node{level(0)},
+			expectedError: "Empty escalation path level",
+		},
+		{
+			name:          "repeat to an unknown node",
+			nodes:         []node{level(1), repeat(3, "start")},
+			expectedError: "Invalid escalation path repeat",
+		},
+		{
+			name:          "repeat no times",
+			nodes:         []node{ifElse("start", []node{level(1), repeat(0, "start")}, nil)},
+			expectedError: "Invalid escalation path repeat",
+		},
+		{
+			name: "node after a repeat",
+			nodes: []node{
+				ifElse("start", []node{level(1), repeat(3, "start"), level(1)}, nil),
+			},
+			expectedError: "Unreachable escalation path node",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			paged, diags := new(IncidentEscalationPathResource).validatePath(nodes(pathType, tc.nodes...), path.Root("path"), nil, false)
+			if tc.expectedError != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tc.expectedError {
+					t.Fatalf("expected error %q, got %v", tc.expectedError, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if paged != tc.expectedPaged {
+				t.Errorf("expected paged to be %v, got %v", tc.expectedPaged, paged)
+			}
+		})
+	}
+}
+
 var escalationPathTemplate = template.Must(template.New("incident_escalation_path").Funcs(sprig.TxtFuncMap()).Parse(`
 # This is the primary schedule that receives pages in working hours.
 resource "incident_schedule" "primary_on_call" {
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentFollowUpsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentFollowUpsDataSource{}
+)
+
+func NewIncidentFollowUpsDataSource() datasource.DataSource {
+	return &IncidentFollowUpsDataSource{}
+}
+
+type IncidentFollowUpsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentFollowUpsDataSourceModel struct {
+	IncidentID   types.String                       `tfsdk:"incident_id"`
+	IncidentMode types.String                       `tfsdk:"incident_mode"`
+	Status       types.Set                          `tfsdk:"status"`
+	Priority     types.Set                          `tfsdk:"priority"`
+	FollowUps    []IncidentFollowUpsDataSourceEntry `tfsdk:"follow_ups"`
+	Limit        types.Int64                        `tfsdk:"limit"`
+	After        types.String                       `tfsdk:"after"`
+	TotalCount   types.Int64                        `tfsdk:"total_count"`
+}
+
+type IncidentFollowUpsDataSourceEntry struct {
+	ID                     types.String `tfsdk:"id"`
+	IncidentID             types.String `tfsdk:"incident_id"`
+	Title                  types.String `tfsdk:"title"`
+	Description            types.String `tfsdk:"description"`
+	Status                 types.String `tfsdk:"status"`
+	Priority               types.String `tfsdk:"priority"`
+	AssigneeID             types.String `tfsdk:"assignee_id"`
+	AssigneeEmail          types.String `tfsdk:"assignee_email"`
+	ExternalIssueName      types.String `tfsdk:"external_issue_name"`
+	ExternalIssuePermalink types.String `tfsdk:"external_issue_permalink"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	CompletedAt            types.String `tfsdk:"completed_at"`
+}
+
+func (i *IncidentFollowUpsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_follow_ups"
+}
+
+func (i *IncidentFollowUpsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists incident follow-ups, optionally only those for one incident, or with a given status or priority. Use it to mirror outstanding follow-ups into other systems.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"incident_id": schema.StringAttribute{
+				MarkdownDescription: "Only return follow-ups for the incident with this ID.",
+				Optional:            true,
+			},
+			"incident_mode": schema.StringAttribute{
+				MarkdownDescription: "Only return follow-ups from incidents in this mode: `standard`, `retrospective`, `test` or `tutorial`. If not set, follow-ups from standard and retrospective incidents are returned.",
+				Optional:            true,
+			},
+			"status": schema.SetAttribute{
+				MarkdownDescription: "Only return follow-ups with one of these statuses: `outstanding`, `completed`, `not_doing` or `deleted`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"priority": schema.SetAttribute{
+				MarkdownDescription: "Only return follow-ups with one of these priorities, by name, such as `High`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"follow_ups": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching follow-ups, in the order the API returns them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("FollowUpV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"incident_id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("FollowUpV2ResponseBody", "incident_id"),
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("FollowUpV2ResponseBody", "title"),
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("FollowUpV2ResponseBody", "description"),
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("FollowUpV2ResponseBody", "status"),
+							Computed:            true,
+						},
+						"priority": schema.StringAttribute{
+							MarkdownDescription: "The name of the follow-up's priority, if it has one.",
+							Computed:            true,
+						},
+						"assignee_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user the follow-up is assigned to.",
+							Computed:            true,
+						},
+						"assignee_email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the user the follow-up is assigned to.",
+							Computed:            true,
+						},
+						"external_issue_name": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue the follow-up has been exported to, in the issue tracker, such as `ENG-123`.",
+							Computed:            true,
+						},
+						"external_issue_permalink": schema.StringAttribute{
+							MarkdownDescription: "Link to the issue the follow-up has been exported to.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the follow-up was created, in RFC3339 format.",
+							Computed:            true,
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: "When the follow-up was completed, in RFC3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentFollowUpsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentFollowUpsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentFollowUpsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &client.FollowUpsV2ListParams{
+		IncidentId: data.IncidentID.ValueStringPointer(),
+	}
+	if !data.IncidentMode.IsNull() {
+		params.IncidentMode = lo.ToPtr(client.FollowUpsV2ListParamsIncidentMode(data.IncidentMode.ValueString()))
+	}
+
+	result, err := i.client.FollowUpsV2ListWithResponse(ctx, params)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list follow-ups, got error: %s", err))
+		return
+	}
+
+	statuses, diags := stringElements(ctx, data.Status)
+	resp.Diagnostics.Append(diags...)
+	priorities, diags := stringElements(ctx, data.Priority)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API can only filter by incident, so we filter by status and priority
+	// ourselves, meaning limit and after apply to the filtered follow-ups.
+	followUps := lo.Filter(result.JSON200.FollowUps, func(followUp client.FollowUpV2, _ int) bool {
+		return followUpMatches(followUp, statuses, priorities)
+	})
+	data.TotalCount = types.Int64Value(int64(len(followUps)))
+
+	followUps = paginateSlice(followUps, func(followUp client.FollowUpV2) string {
+		return followUp.Id
+	}, data.Limit, data.After)
+
+	data.FollowUps = lo.Map(followUps, func(followUp client.FollowUpV2, _ int) IncidentFollowUpsDataSourceEntry {
+		entry := IncidentFollowUpsDataSourceEntry{
+			ID:                     types.StringValue(followUp.Id),
+			IncidentID:             types.StringValue(followUp.IncidentId),
+			Title:                  types.StringValue(followUp.Title),
+			Description:            types.StringPointerValue(followUp.Description),
+			Status:                 types.StringValue(string(followUp.Status)),
+			Priority:               types.StringNull(),
+			AssigneeID:             types.StringNull(),
+			AssigneeEmail:          types.StringNull(),
+			ExternalIssueName:      types.StringNull(),
+			ExternalIssuePermalink: types.StringNull(),
+			CreatedAt:              types.StringValue(followUp.CreatedAt.Format(time.RFC3339)),
+			CompletedAt:            types.StringNull(),
+		}
+		if followUp.Priority != nil {
+			entry.Priority = types.StringValue(followUp.Priority.Name)
+		}
+		if followUp.Assignee != nil {
+			entry.AssigneeID = types.StringValue(followUp.Assignee.Id)
+			entry.AssigneeEmail = types.StringPointerValue(followUp.Assignee.Email)
+		}
+		if followUp.ExternalIssueReference != nil {
+			entry.ExternalIssueName = types.StringValue(followUp.ExternalIssueReference.IssueName)
+			entry.ExternalIssuePermalink = types.StringValue(followUp.ExternalIssueReference.IssuePermalink)
+		}
+		if followUp.CompletedAt != nil {
+			entry.CompletedAt = types.StringValue(followUp.CompletedAt.Format(time.RFC3339))
+		}
+
+		return entry
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// followUpMatches returns true if the follow-up has one of the statuses and one of the
+// priorities, ignoring either if empty. Follow-ups without a priority never match a
+// priority filter.
+func followUpMatches(followUp client.FollowUpV2, statuses, priorities []string) bool {
+	if len(statuses) > 0 && !lo.Contains(statuses, string(followUp.Status)) {
+		return false
+	}
+	if len(priorities) > 0 && (followUp.Priority == nil || !lo.Contains(priorities, followUp.Priority.Name)) {
+		return false
+	}
+
+	return true
+}
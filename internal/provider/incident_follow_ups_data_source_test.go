@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestFollowUpMatches(t *testing.T) {
+	followUp := client.FollowUpV2{
+		Status:   client.Outstanding,
+		Priority: &client.FollowUpPriorityV2{Name: "High"},
+	}
+	unprioritised := client.FollowUpV2{
+		Status: client.Outstanding,
+	}
+
+	for _, tc := range []struct {
+		name       string
+		followUp   client.FollowUpV2
+		statuses   []string
+		priorities []string
+		expected   bool
+	}{
+		{"no filters", followUp, nil, nil, true},
+		{"matching status", followUp, []string{"outstanding", "completed"}, nil, true},
+		{"other status", followUp, []string{"completed"}, nil, false},
+		{"matching priority", followUp, nil, []string{"High"}, true},
+		{"other priority", followUp, nil, []string{"Low"}, false},
+		{"no priority", unprioritised, nil, []string{"High"}, false},
+		{"matching status and priority", followUp, []string{"outstanding"}, []string{"High"}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := followUpMatches(tc.followUp, tc.statuses, tc.priorities); got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccIncidentFollowUpsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentIncidentResourceConfig(nil) + `
+data "incident_follow_ups" "example" {
+  incident_id   = incident_incident.example.id
+  incident_mode = "test"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.incident_follow_ups.example", "follow_ups.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.incident_follow_ups.example", "total_count", "0"),
+				),
+			},
+		},
+	})
+}
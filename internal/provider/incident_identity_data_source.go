@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentIdentityDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentIdentityDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentIdentityDataSource{}
+)
+
+func NewIncidentIdentityDataSource() datasource.DataSource {
+	return &IncidentIdentityDataSource{}
+}
+
+type IncidentIdentityDataSource struct {
+	providerData *IncidentProviderData
+}
+
+type IncidentIdentityDataSourceModel struct {
+	APIKeyFamily types.String `tfsdk:"api_key_family"`
+	Name         types.String `tfsdk:"name"`
+	Roles        types.Set    `tfsdk:"roles"`
+	DashboardURL types.String `tfsdk:"dashboard_url"`
+}
+
+func (i *IncidentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+func (i *IncidentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source describes the API key the provider is using, including the roles it has been given. Use it in preconditions to fail early, with a clear error, when the key can't make the changes a plan needs.",
+		Attributes: map[string]schema.Attribute{
+			"api_key_family": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Describe the key used for this family of resources from the provider's `api_keys`, rather than `api_key`. One of: %s.", strings.Join(lo.Map(apiKeyFamilies, func(family string, _ int) string {
+					return fmt.Sprintf("`%s`", family)
+				}), ", ")),
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IdentityV1ResponseBody", "name"),
+				Computed:            true,
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: apischema.Docstring("IdentityV1ResponseBody", "roles"),
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"dashboard_url": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IdentityV1ResponseBody", "dashboard_url"),
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (i *IncidentIdentityDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IncidentIdentityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.APIKeyFamily.IsNull() && !data.APIKeyFamily.IsUnknown() && !lo.Contains(apiKeyFamilies, data.APIKeyFamily.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key_family"),
+			"Unknown API key family",
+			fmt.Sprintf("api_key_family must be one of %s, got %q.", strings.Join(apiKeyFamilies, ", "), data.APIKeyFamily.ValueString()),
+		)
+	}
+}
+
+func (i *IncidentIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	// Which client we use depends on the config, so we pick it when reading.
+	i.providerData = client
+}
+
+func (i *IncidentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentIdentityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// With no family set this is the client for api_key.
+	apiClient := i.providerData.ClientFor(data.APIKeyFamily.ValueString())
+
+	result, err := apiClient.UtilitiesV1IdentityWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read identity, got error: %s", err))
+		return
+	}
+
+	identity := result.JSON200.Identity
+	data.Name = types.StringValue(identity.Name)
+	data.Roles = stringSetValue(lo.Map(identity.Roles, func(role client.IdentityV1Roles, _ int) string {
+		return string(role)
+	}))
+	data.DashboardURL = types.StringValue(identity.DashboardUrl)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentIdentityDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "incident_identity" "current" {}
+
+data "incident_identity" "catalog" {
+  api_key_family = "catalog"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.incident_identity.current", "name"),
+					resource.TestCheckResourceAttrSet(
+						"data.incident_identity.current", "dashboard_url"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_identity.catalog", "name",
+						"data.incident_identity.current", "name"),
+				),
+			},
+			{
+				Config: `
+data "incident_identity" "unknown" {
+  api_key_family = "alerts"
+}
+`,
+				ExpectError: regexp.MustCompile("api_key_family must be one of"),
+			},
+		},
+	})
+}
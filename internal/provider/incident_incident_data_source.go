@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentIncidentDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentIncidentDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentIncidentDataSource{}
+)
+
+func NewIncidentIncidentDataSource() datasource.DataSource {
+	return &IncidentIncidentDataSource{}
+}
+
+type IncidentIncidentDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIncidentDataSourceModel struct {
+	ID                     types.String                       `tfsdk:"id"`
+	Reference              types.String                       `tfsdk:"reference"`
+	Name                   types.String                       `tfsdk:"name"`
+	Summary                types.String                       `tfsdk:"summary"`
+	Mode                   types.String                       `tfsdk:"mode"`
+	Visibility             types.String                       `tfsdk:"visibility"`
+	Permalink              types.String                       `tfsdk:"permalink"`
+	IncidentStatusID       types.String                       `tfsdk:"incident_status_id"`
+	IncidentStatusCategory types.String                       `tfsdk:"incident_status_category"`
+	SeverityID             types.String                       `tfsdk:"severity_id"`
+	IncidentTypeID         types.String                       `tfsdk:"incident_type_id"`
+	CustomFieldEntries     []IncidentIncidentCustomFieldEntry `tfsdk:"custom_field_entries"`
+	RoleAssignments        []IncidentIncidentRoleAssignment   `tfsdk:"role_assignments"`
+}
+
+type IncidentIncidentCustomFieldEntry struct {
+	CustomFieldID   types.String `tfsdk:"custom_field_id"`
+	CustomFieldName types.String `tfsdk:"custom_field_name"`
+	Values          types.List   `tfsdk:"values"`
+}
+
+type IncidentIncidentRoleAssignment struct {
+	IncidentRoleID types.String `tfsdk:"incident_role_id"`
+	RoleName       types.String `tfsdk:"role_name"`
+	AssigneeID     types.String `tfsdk:"assignee_id"`
+	AssigneeEmail  types.String `tfsdk:"assignee_email"`
+}
+
+func (i *IncidentIncidentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident"
+}
+
+func (i *IncidentIncidentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source fetches a single incident by ID or reference, such as `INC-123`, including its status, severity, custom field values and role assignments. Use it to check the state of an incident from runbook automation or other tooling.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "id"),
+				Optional:            true,
+				Computed:            true,
+			},
+			"reference": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "reference"),
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "name"),
+				Computed:            true,
+			},
+			"summary": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "summary"),
+				Computed:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "mode"),
+				Computed:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "visibility"),
+				Computed:            true,
+			},
+			"permalink": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "permalink"),
+				Computed:            true,
+			},
+			"incident_status_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the incident's current status.",
+				Computed:            true,
+			},
+			"incident_status_category": schema.StringAttribute{
+				MarkdownDescription: "The category of the incident's current status, such as `live` or `closed`.",
+				Computed:            true,
+			},
+			"severity_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the incident's severity, if it has one.",
+				Computed:            true,
+			},
+			"incident_type_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the incident's type, if it has one.",
+				Computed:            true,
+			},
+			"custom_field_entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The values of the incident's custom fields.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"custom_field_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the custom field.",
+							Computed:            true,
+						},
+						"custom_field_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the custom field.",
+							Computed:            true,
+						},
+						"values": schema.ListAttribute{
+							MarkdownDescription: "The values of the custom field. These are option IDs for select fields, catalog entry IDs for catalog fields, and the value itself for text, link and numeric fields.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"role_assignments": schema.ListNestedAttribute{
+				MarkdownDescription: "Who holds each incident role. Roles nobody holds have no assignee.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"incident_role_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the incident role.",
+							Computed:            true,
+						},
+						"role_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the incident role.",
+							Computed:            true,
+						},
+						"assignee_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user holding the role.",
+							Computed:            true,
+						},
+						"assignee_email": schema.StringAttribute{
+							MarkdownDescription: "The email address of the user holding the role.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (i *IncidentIncidentDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IncidentIncidentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Reference.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid incident lookup",
+			"Exactly one of id or reference must be set.",
+		)
+	}
+}
+
+func (i *IncidentIncidentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentIncidentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentIncidentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+	if !data.Reference.IsNull() {
+		var err error
+		id, err = incidentIDForReference(data.Reference.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid incident reference", err.Error())
+			return
+		}
+	}
+
+	result, err := i.client.IncidentsV2ShowWithResponse(ctx, id)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident, got error: %s", err))
+		return
+	}
+
+	incident := result.JSON200.Incident
+	model := new(IncidentIncidentResource).buildModel(incident)
+	modelResp := IncidentIncidentDataSourceModel{
+		ID:                     model.ID,
+		Reference:              model.Reference,
+		Name:                   model.Name,
+		Summary:                model.Summary,
+		Mode:                   model.Mode,
+		Visibility:             model.Visibility,
+		Permalink:              model.Permalink,
+		IncidentStatusID:       model.IncidentStatusID,
+		IncidentStatusCategory: types.StringValue(string(incident.IncidentStatus.Category)),
+		SeverityID:             model.SeverityID,
+		IncidentTypeID:         model.IncidentTypeID,
+		CustomFieldEntries:     buildIncidentCustomFieldEntries(incident.CustomFieldEntries),
+		RoleAssignments: lo.Map(incident.IncidentRoleAssignments, func(assignment client.IncidentRoleAssignmentV1, _ int) IncidentIncidentRoleAssignment {
+			result := IncidentIncidentRoleAssignment{
+				IncidentRoleID: types.StringValue(assignment.Role.Id),
+				RoleName:       types.StringValue(assignment.Role.Name),
+				AssigneeID:     types.StringNull(),
+				AssigneeEmail:  types.StringNull(),
+			}
+			if assignment.Assignee != nil {
+				result.AssigneeID = types.StringValue(assignment.Assignee.Id)
+				result.AssigneeEmail = types.StringPointerValue(assignment.Assignee.Email)
+			}
+
+			return result
+		}),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
+
+// incidentIDForReference converts a reference like INC-123 into the numeric ID the show
+// incident endpoint accepts in place of the incident's ULID.
+func incidentIDForReference(reference string) (string, error) {
+	number := strings.TrimPrefix(strings.ToUpper(reference), "INC-")
+	if _, err := strconv.ParseUint(number, 10, 64); err != nil {
+		return "", fmt.Errorf("expected a reference like INC-123, got %q", reference)
+	}
+
+	return number, nil
+}
+
+// buildIncidentCustomFieldEntries flattens each custom field's values into strings,
+// whatever the type of the field.
+func buildIncidentCustomFieldEntries(entries []client.CustomFieldEntryV1) []IncidentIncidentCustomFieldEntry {
+	return lo.Map(entries, func(entry client.CustomFieldEntryV1, _ int) IncidentIncidentCustomFieldEntry {
+		values := lo.FilterMap(entry.Values, func(value client.CustomFieldValueV1, _ int) (string, bool) {
+			switch {
+			case value.ValueOption != nil:
+				return value.ValueOption.Id, true
+			case value.ValueCatalogEntry != nil:
+				return value.ValueCatalogEntry.Id, true
+			case value.ValueText != nil:
+				return *value.ValueText, true
+			case value.ValueLink != nil:
+				return *value.ValueLink, true
+			case value.ValueNumeric != nil:
+				return *value.ValueNumeric, true
+			default:
+				return "", false
+			}
+		})
+
+		return IncidentIncidentCustomFieldEntry{
+			CustomFieldID:   types.StringValue(entry.CustomField.Id),
+			CustomFieldName: types.StringValue(entry.CustomField.Name),
+			Values:          stringListValue(values),
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestIncidentIDForReference(t *testing.T) {
+	for _, tc := range []struct {
+		reference   string
+		expectedID  string
+		expectedErr bool
+	}{
+		{reference: "INC-123", expectedID: "123"},
+		{reference: "inc-45", expectedID: "45"},
+		{reference: "123", expectedID: "123"},
+		{reference: "INC-", expectedErr: true},
+		{reference: "01FDAG4SAP5TYPT98WGR2N7W91", expectedErr: true},
+	} {
+		t.Run(tc.reference, func(t *testing.T) {
+			id, err := incidentIDForReference(tc.reference)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id != tc.expectedID {
+				t.Errorf("expected %s, got %s", tc.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestBuildIncidentCustomFieldEntries(t *testing.T) {
+	entries := buildIncidentCustomFieldEntries([]client.CustomFieldEntryV1{
+		{
+			CustomField: client.CustomFieldTypeInfoV1{Id: "affected-teams", Name: "Affected teams"},
+			Values: []client.CustomFieldValueV1{
+				{ValueCatalogEntry: &client.EmbeddedCatalogEntryV1{Id: "payments"}},
+				{ValueCatalogEntry: &client.EmbeddedCatalogEntryV1{Id: "search"}},
+			},
+		},
+		{
+			CustomField: client.CustomFieldTypeInfoV1{Id: "impact", Name: "Impact"},
+			Values: []client.CustomFieldValueV1{
+				{ValueOption: &client.CustomFieldOptionV1{Id: "high"}},
+			},
+		},
+		{
+			CustomField: client.CustomFieldTypeInfoV1{Id: "customers", Name: "Customers affected"},
+			Values: []client.CustomFieldValueV1{
+				{ValueNumeric: lo.ToPtr("42")},
+			},
+		},
+		{
+			CustomField: client.CustomFieldTypeInfoV1{Id: "notes", Name: "Notes"},
+			Values:      []client.CustomFieldValueV1{},
+		},
+	})
+
+	got := lo.SliceToMap(entries, func(entry IncidentIncidentCustomFieldEntry) (string, []string) {
+		return entry.CustomFieldID.ValueString(), lo.Map(entry.Values.Elements(), func(value attr.Value, _ int) string {
+			return value.(types.String).ValueString()
+		})
+	})
+	expected := map[string][]string{
+		"affected-teams": {"payments", "search"},
+		"impact":         {"high"},
+		"customers":      {"42"},
+		"notes":          {},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestAccIncidentIncidentDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentIncidentResourceConfig(nil) + `
+data "incident_incident" "by_id" {
+  id = incident_incident.example.id
+}
+
+data "incident_incident" "by_reference" {
+  reference = incident_incident.example.reference
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.incident_incident.by_id", "reference",
+						"incident_incident.example", "reference"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_incident.by_reference", "id",
+						"incident_incident.example", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_incident.by_reference", "incident_status_id",
+						"incident_incident.example", "incident_status_id"),
+				),
+			},
+		},
+	})
+}
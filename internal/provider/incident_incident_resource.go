@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ resource.Resource                   = &IncidentIncidentResource{}
+	_ resource.ResourceWithImportState    = &IncidentIncidentResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentIncidentResource{}
+)
+
+// incidentResourceModes are the only modes we allow incidents to be created in, as
+// this resource is for standing test and game-day incidents rather than real ones.
+var incidentResourceModes = []string{
+	string(client.CreateRequestBody10ModeTest),
+	string(client.CreateRequestBody10ModeTutorial),
+}
+
+type IncidentIncidentResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIncidentResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Summary          types.String `tfsdk:"summary"`
+	Mode             types.String `tfsdk:"mode"`
+	Visibility       types.String `tfsdk:"visibility"`
+	SeverityID       types.String `tfsdk:"severity_id"`
+	IncidentTypeID   types.String `tfsdk:"incident_type_id"`
+	IncidentStatusID types.String `tfsdk:"incident_status_id"`
+	Reference        types.String `tfsdk:"reference"`
+	Permalink        types.String `tfsdk:"permalink"`
+}
+
+func NewIncidentIncidentResource() resource.Resource {
+	return &IncidentIncidentResource{}
+}
+
+func (r *IncidentIncidentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident"
+}
+
+func (r *IncidentIncidentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage test and tutorial incidents, such as standing incidents used for game days.
+
+Only incidents in ` + "`test`" + ` or ` + "`tutorial`" + ` mode can be created with this resource.
+
+The incident.io API does not support closing or deleting incidents, so destroying this
+resource only removes it from the Terraform state: the incident must be closed from the
+incident.io dashboard.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentsV2CreateRequestBody", "name"),
+				Required:            true,
+			},
+			"summary": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentsV2CreateRequestBody", "summary"),
+				Optional:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the incident is a test or a tutorial. One of `test` or `tutorial`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentsV2CreateRequestBody", "visibility"),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(client.CreateRequestBody10VisibilityPublic)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"severity_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentsV2CreateRequestBody", "severity_id"),
+				Optional:            true,
+				Computed:            true, // If not provided, the incident gets the default severity
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"incident_type_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentsV2CreateRequestBody", "incident_type_id"),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"incident_status_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentsV2CreateRequestBody", "incident_status_id"),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reference": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "reference"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"permalink": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "permalink"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IncidentIncidentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (r *IncidentIncidentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *IncidentIncidentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() && !lo.Contains(incidentResourceModes, data.Mode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mode"),
+			"Invalid incident mode",
+			fmt.Sprintf("Only test and tutorial incidents can be managed by Terraform, got: %s", data.Mode.ValueString()),
+		)
+	}
+}
+
+func (r *IncidentIncidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentIncidentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.IncidentsV2CreateWithResponse(ctx, client.IncidentsV2CreateJSONRequestBody{
+		IdempotencyKey:   uuid.NewString(),
+		Name:             data.Name.ValueStringPointer(),
+		Summary:          data.Summary.ValueStringPointer(),
+		Mode:             lo.ToPtr(client.CreateRequestBody10Mode(data.Mode.ValueString())),
+		Visibility:       client.CreateRequestBody10Visibility(data.Visibility.ValueString()),
+		SeverityId:       knownStringPointer(data.SeverityID),
+		IncidentTypeId:   knownStringPointer(data.IncidentTypeID),
+		IncidentStatusId: knownStringPointer(data.IncidentStatusID),
+	})
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created an incident resource with id=%s", result.JSON200.Incident.Id))
+	data = r.buildModel(result.JSON200.Incident)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentIncidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentIncidentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.IncidentsV2ShowWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident, got error: %s", err))
+		return
+	}
+
+	if result.StatusCode() == 404 {
+		resp.Diagnostics.AddWarning("Not Found", fmt.Sprintf("Unable to read incident, got status code: %d", result.StatusCode()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.Incident)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentIncidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentIncidentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.IncidentsV2EditWithResponse(ctx, data.ID.ValueString(), client.IncidentsV2EditJSONRequestBody{
+		Incident: client.IncidentEditPayloadV2{
+			Name:             data.Name.ValueStringPointer(),
+			Summary:          data.Summary.ValueStringPointer(),
+			SeverityId:       knownStringPointer(data.SeverityID),
+			IncidentStatusId: knownStringPointer(data.IncidentStatusID),
+		},
+		// Test incidents are often used for game days, where we don't want to interrupt
+		// the responders with notifications about configuration changes.
+		NotifyIncidentChannel: false,
+	})
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident, got error: %s", err))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.Incident)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentIncidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentIncidentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API has no way to close or delete an incident, so all we can do is forget it.
+	resp.Diagnostics.AddWarning(
+		"Incident not closed",
+		fmt.Sprintf("Incident %s has been removed from the Terraform state, but the incident.io API does not support closing incidents. Close it from the incident.io dashboard.", data.Reference.ValueString()),
+	)
+}
+
+func (r *IncidentIncidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *IncidentIncidentResource) buildModel(incident client.IncidentV2) *IncidentIncidentResourceModel {
+	model := &IncidentIncidentResourceModel{
+		ID:               types.StringValue(incident.Id),
+		Name:             types.StringValue(incident.Name),
+		Mode:             types.StringValue(string(incident.Mode)),
+		Visibility:       types.StringValue(string(incident.Visibility)),
+		IncidentStatusID: types.StringValue(incident.IncidentStatus.Id),
+		Reference:        types.StringValue(incident.Reference),
+		Permalink:        types.StringPointerValue(incident.Permalink),
+		Summary:          types.StringPointerValue(incident.Summary),
+	}
+	if incident.Severity != nil {
+		model.SeverityID = types.StringValue(incident.Severity.Id)
+	}
+	if incident.IncidentType != nil {
+		model.IncidentTypeID = types.StringValue(incident.IncidentType.Id)
+	}
+
+	return model
+}
+
+// knownStringPointer returns nil for computed attributes that haven't been set in
+// config, rather than the empty string ValueStringPointer gives for unknown values.
+func knownStringPointer(value types.String) *string {
+	if value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueStringPointer()
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"testing"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentIncidentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentIncidentResourceConfig(nil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_incident.example", "name", incidentIncidentDefault().Name),
+					resource.TestCheckResourceAttr(
+						"incident_incident.example", "mode", "test"),
+					resource.TestCheckResourceAttr(
+						"incident_incident.example", "visibility", "public"),
+					resource.TestCheckResourceAttrSet(
+						"incident_incident.example", "reference"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_incident.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and read
+			{
+				Config: testAccIncidentIncidentResourceConfig(&client.IncidentV2{
+					Name: "Game day: cache failover",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_incident.example", "name", "Game day: cache failover"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIncidentIncidentResourceStandardMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentIncidentResourceConfig(&client.IncidentV2{
+					Mode: client.IncidentV2ModeStandard,
+				}),
+				ExpectError: regexp.MustCompile(`Only test and tutorial incidents can be managed by Terraform`),
+			},
+		},
+	})
+}
+
+var incidentIncidentTemplate = template.Must(template.New("incident_incident").Funcs(sprig.TxtFuncMap()).Parse(`
+resource "incident_incident" "example" {
+  name = {{ quote .Name }}
+  mode = {{ quote .Mode }}
+}
+`))
+
+func incidentIncidentDefault() client.IncidentV2 {
+	return client.IncidentV2{
+		Name: "Game day: database failover",
+		Mode: client.IncidentV2ModeTest,
+	}
+}
+
+func testAccIncidentIncidentResourceConfig(override *client.IncidentV2) string {
+	model := incidentIncidentDefault()
+
+	// Merge any non-zero fields in override into the model.
+	if override != nil {
+		for idx := 0; idx < reflect.TypeOf(*override).NumField(); idx++ {
+			field := reflect.ValueOf(*override).Field(idx)
+			if !field.IsZero() {
+				reflect.ValueOf(&model).Elem().Field(idx).Set(field)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := incidentIncidentTemplate.Execute(&buf, model); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
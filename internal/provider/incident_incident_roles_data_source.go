@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentIncidentRolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentIncidentRolesDataSource{}
+)
+
+func NewIncidentIncidentRolesDataSource() datasource.DataSource {
+	return &IncidentIncidentRolesDataSource{}
+}
+
+type IncidentIncidentRolesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIncidentRolesDataSourceModel struct {
+	Limit         types.Int64                            `tfsdk:"limit"`
+	After         types.String                           `tfsdk:"after"`
+	TotalCount    types.Int64                            `tfsdk:"total_count"`
+	IncidentRoles []IncidentIncidentRolesDataSourceEntry `tfsdk:"incident_roles"`
+}
+
+type IncidentIncidentRolesDataSourceEntry struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Instructions types.String `tfsdk:"instructions"`
+	Shortform    types.String `tfsdk:"shortform"`
+	RoleType     types.String `tfsdk:"role_type"`
+}
+
+func (i *IncidentIncidentRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_roles"
+}
+
+func (i *IncidentIncidentRolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists every incident role in your organisation, including the built-in lead and reporter roles. Use it to check the roles a module depends on exist, or to configure something for each role with `for_each`.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"incident_roles": schema.ListNestedAttribute{
+				MarkdownDescription: "The incident roles, in the order the API returns them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentRoleV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentRoleV2ResponseBody", "name"),
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentRoleV2ResponseBody", "description"),
+							Computed:            true,
+						},
+						"instructions": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentRoleV2ResponseBody", "instructions"),
+							Computed:            true,
+						},
+						"shortform": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentRoleV2ResponseBody", "shortform"),
+							Computed:            true,
+						},
+						"role_type": schema.StringAttribute{
+							MarkdownDescription: "Type of incident role: `lead` or `reporter` for the built-in roles, and `custom` for any others.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentIncidentRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentIncidentRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentIncidentRolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.IncidentRolesV2ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident roles, got error: %s", err))
+		return
+	}
+
+	data.TotalCount = types.Int64Value(int64(len(result.JSON200.IncidentRoles)))
+
+	roles := paginateSlice(result.JSON200.IncidentRoles, func(role client.IncidentRoleV2) string {
+		return role.Id
+	}, data.Limit, data.After)
+
+	data.IncidentRoles = lo.Map(roles, func(role client.IncidentRoleV2, _ int) IncidentIncidentRolesDataSourceEntry {
+		model := new(IncidentRoleResource).buildModel(role, &IncidentRoleResourceModel{})
+
+		return IncidentIncidentRolesDataSourceEntry{
+			ID:           model.ID,
+			Name:         model.Name,
+			Description:  model.Description,
+			Instructions: model.Instructions,
+			Shortform:    model.Shortform,
+			RoleType:     types.StringValue(string(role.RoleType)),
+		}
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
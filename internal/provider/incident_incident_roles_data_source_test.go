@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentIncidentRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentRoleResourceConfig(nil) + `
+data "incident_incident_roles" "all" {
+  depends_on = [incident_incident_role.example]
+}
+
+data "incident_incident_roles" "after_first" {
+  after = data.incident_incident_roles.all.incident_roles[0].id
+  limit = 1
+}
+
+locals {
+  example = one([for role in data.incident_incident_roles.all.incident_roles : role if role.id == incident_incident_role.example.id])
+}
+
+output "example_shortform" {
+  value = local.example.shortform
+}
+
+output "example_role_type" {
+  value = local.example.role_type
+}
+
+output "has_lead" {
+  value = contains([for role in data.incident_incident_roles.all.incident_roles : role.role_type], "lead")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("example_shortform", "communications"),
+					resource.TestCheckOutput("example_role_type", "custom"),
+					resource.TestCheckOutput("has_lead", "true"),
+					resource.TestCheckResourceAttr(
+						"data.incident_incident_roles.after_first", "incident_roles.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_incident_roles.after_first", "incident_roles.0.id",
+						"data.incident_incident_roles.all", "incident_roles.1.id"),
+				),
+			},
+		},
+	})
+}
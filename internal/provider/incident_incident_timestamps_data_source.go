@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentIncidentTimestampsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentIncidentTimestampsDataSource{}
+)
+
+func NewIncidentIncidentTimestampsDataSource() datasource.DataSource {
+	return &IncidentIncidentTimestampsDataSource{}
+}
+
+type IncidentIncidentTimestampsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIncidentTimestampsDataSourceModel struct {
+	Limit      types.Int64                      `tfsdk:"limit"`
+	After      types.String                     `tfsdk:"after"`
+	TotalCount types.Int64                      `tfsdk:"total_count"`
+	Timestamps []IncidentIncidentTimestampModel `tfsdk:"timestamps"`
+	IDs        types.Map                        `tfsdk:"ids"`
+}
+
+type IncidentIncidentTimestampModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Rank types.Int64  `tfsdk:"rank"`
+}
+
+func (i *IncidentIncidentTimestampsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_timestamps"
+}
+
+func (i *IncidentIncidentTimestampsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists the incident timestamps configured for your organisation, such as when impact started or when the incident was resolved. Use it to refer to timestamps by name in policies and dashboards configured elsewhere.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"timestamps": schema.ListNestedAttribute{
+				MarkdownDescription: "The incident timestamps, in the order they're shown on an incident.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentTimestampV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentTimestampV2ResponseBody", "name"),
+							Computed:            true,
+						},
+						"rank": schema.Int64Attribute{
+							MarkdownDescription: apischema.Docstring("IncidentTimestampV2ResponseBody", "rank"),
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				MarkdownDescription: "The ID of each incident timestamp in `timestamps`, keyed by its name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (i *IncidentIncidentTimestampsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentIncidentTimestampsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentIncidentTimestampsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.IncidentTimestampsV2ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident timestamps, got error: %s", err))
+		return
+	}
+
+	timestamps := buildIncidentTimestampsByRank(result.JSON200.IncidentTimestamps)
+	data.TotalCount = types.Int64Value(int64(len(timestamps)))
+
+	data.Timestamps = paginateSlice(timestamps, func(timestamp IncidentIncidentTimestampModel) string {
+		return timestamp.ID.ValueString()
+	}, data.Limit, data.After)
+	data.IDs = types.MapValueMust(types.StringType, lo.SliceToMap(data.Timestamps, func(timestamp IncidentIncidentTimestampModel) (string, attr.Value) {
+		return timestamp.Name.ValueString(), timestamp.ID
+	}))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildIncidentTimestampsByRank returns models for each timestamp, ordered by rank.
+// Timestamps with the same rank keep the order the API returned them in.
+func buildIncidentTimestampsByRank(timestamps []client.IncidentTimestampV2) []IncidentIncidentTimestampModel {
+	timestamps = append([]client.IncidentTimestampV2{}, timestamps...)
+	sort.SliceStable(timestamps, func(a, b int) bool {
+		return timestamps[a].Rank < timestamps[b].Rank
+	})
+
+	return lo.Map(timestamps, func(timestamp client.IncidentTimestampV2, _ int) IncidentIncidentTimestampModel {
+		return IncidentIncidentTimestampModel{
+			ID:   types.StringValue(timestamp.Id),
+			Name: types.StringValue(timestamp.Name),
+			Rank: types.Int64Value(timestamp.Rank),
+		}
+	})
+}
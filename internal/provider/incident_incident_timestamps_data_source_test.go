@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestBuildIncidentTimestampsByRank(t *testing.T) {
+	timestamps := buildIncidentTimestampsByRank([]client.IncidentTimestampV2{
+		{Id: "resolved", Name: "Resolved at", Rank: 3},
+		{Id: "impact", Name: "Impact started", Rank: 1},
+		{Id: "reported", Name: "Reported at", Rank: 2},
+		{Id: "detected", Name: "Detected at", Rank: 1},
+	})
+
+	ids := lo.Map(timestamps, func(timestamp IncidentIncidentTimestampModel, _ int) string {
+		return timestamp.ID.ValueString()
+	})
+	if expected := []string{"impact", "detected", "reported", "resolved"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestAccIncidentIncidentTimestampsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "incident_incident_timestamps" "all" {}
+
+data "incident_incident_timestamps" "first" {
+  limit = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.incident_incident_timestamps.all", "timestamps.0.id"),
+					resource.TestCheckResourceAttrSet(
+						"data.incident_incident_timestamps.all", "ids.%"),
+					resource.TestCheckResourceAttr(
+						"data.incident_incident_timestamps.first", "timestamps.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.incident_incident_timestamps.first", "ids.%", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentIncidentTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentIncidentTypeDataSource{}
+)
+
+func NewIncidentIncidentTypeDataSource() datasource.DataSource {
+	return &IncidentIncidentTypeDataSource{}
+}
+
+type IncidentIncidentTypeDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIncidentTypeDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	IsDefault            types.Bool   `tfsdk:"is_default"`
+	CreateInTriage       types.String `tfsdk:"create_in_triage"`
+	PrivateIncidentsOnly types.Bool   `tfsdk:"private_incidents_only"`
+}
+
+func (i *IncidentIncidentTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_type"
+}
+
+func (i *IncidentIncidentTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source looks up an incident type by name. Incident types are configured in the incident.io dashboard, so use this to refer to them from custom fields and workflows without hardcoding their ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentTypeV1ResponseBody", "id"),
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentTypeV1ResponseBody", "name"),
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentTypeV1ResponseBody", "description"),
+				Computed:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentTypeV1ResponseBody", "is_default"),
+				Computed:            true,
+			},
+			"create_in_triage": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentTypeV1ResponseBody", "create_in_triage"),
+				Computed:            true,
+			},
+			"private_incidents_only": schema.BoolAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentTypeV1ResponseBody", "private_incidents_only"),
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (i *IncidentIncidentTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentIncidentTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentIncidentTypeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.IncidentTypesV1ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident types, got error: %s", err))
+		return
+	}
+
+	incidentType, ok := lo.Find(result.JSON200.IncidentTypes, func(incidentType client.IncidentTypeV1) bool {
+		return incidentType.Name == data.Name.ValueString()
+	})
+	if !ok {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find incident type, got error: no incident type with name %q", data.Name.ValueString()))
+		return
+	}
+
+	modelResp := IncidentIncidentTypeDataSourceModel{
+		ID:                   types.StringValue(incidentType.Id),
+		Name:                 types.StringValue(incidentType.Name),
+		Description:          types.StringValue(incidentType.Description),
+		IsDefault:            types.BoolValue(incidentType.IsDefault),
+		CreateInTriage:       types.StringValue(string(incidentType.CreateInTriage)),
+		PrivateIncidentsOnly: types.BoolValue(incidentType.PrivateIncidentsOnly),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIncidentIncidentTypeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Incident types can't be created through the API, so we can only check the
+			// lookup fails cleanly for one that doesn't exist.
+			{
+				Config: `
+data "incident_incident_type" "missing" {
+  name = "Terraform acceptance test missing type"
+}
+`,
+				ExpectError: regexp.MustCompile(`no incident type with name "Terraform acceptance test missing type"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentIncidentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentIncidentsDataSource{}
+)
+
+func NewIncidentIncidentsDataSource() datasource.DataSource {
+	return &IncidentIncidentsDataSource{}
+}
+
+type IncidentIncidentsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIncidentsDataSourceModel struct {
+	StatusCategory    types.Set                          `tfsdk:"status_category"`
+	SeverityID        types.Set                          `tfsdk:"severity_id"`
+	MinimumSeverityID types.String                       `tfsdk:"minimum_severity_id"`
+	IncidentTypeID    types.Set                          `tfsdk:"incident_type_id"`
+	Mode              types.Set                          `tfsdk:"mode"`
+	CreatedAfter      types.String                       `tfsdk:"created_after"`
+	Incidents         []IncidentIncidentsDataSourceEntry `tfsdk:"incidents"`
+	Limit             types.Int64                        `tfsdk:"limit"`
+	After             types.String                       `tfsdk:"after"`
+	TotalCount        types.Int64                        `tfsdk:"total_count"`
+}
+
+type IncidentIncidentsDataSourceEntry struct {
+	ID                     types.String `tfsdk:"id"`
+	Reference              types.String `tfsdk:"reference"`
+	Name                   types.String `tfsdk:"name"`
+	Mode                   types.String `tfsdk:"mode"`
+	Permalink              types.String `tfsdk:"permalink"`
+	IncidentStatusID       types.String `tfsdk:"incident_status_id"`
+	IncidentStatusCategory types.String `tfsdk:"incident_status_category"`
+	SeverityID             types.String `tfsdk:"severity_id"`
+	IncidentTypeID         types.String `tfsdk:"incident_type_id"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+}
+
+func (i *IncidentIncidentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incidents"
+}
+
+func (i *IncidentIncidentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists incidents matching the same filters as the List Incidents API. Use it to drive reporting, or to gate a pipeline on there being no open incidents of a given severity.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"status_category": schema.SetAttribute{
+				MarkdownDescription: "Only return incidents whose status is in one of these categories: `triage`, `active`, `post-incident`, `closed`, `declined`, `merged` or `canceled`. If not set, the API returns incidents in triage, active, post-incident and closed statuses.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"severity_id": schema.SetAttribute{
+				MarkdownDescription: "Only return incidents with one of these severities.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"minimum_severity_id": schema.StringAttribute{
+				MarkdownDescription: "Only return incidents with this severity, or one ranked above it.",
+				Optional:            true,
+			},
+			"incident_type_id": schema.SetAttribute{
+				MarkdownDescription: "Only return incidents of one of these incident types.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"mode": schema.SetAttribute{
+				MarkdownDescription: "Only return incidents in one of these modes: `standard`, `retrospective`, `test` or `tutorial`. If not set, the API returns standard and retrospective incidents.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only return incidents created on or after this date, such as `2024-01-01`.",
+				Optional:            true,
+			},
+			"incidents": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching incidents, in the order the API returns them.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"reference": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "reference"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "name"),
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "mode"),
+							Computed:            true,
+						},
+						"permalink": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentV2ResponseBody", "permalink"),
+							Computed:            true,
+						},
+						"incident_status_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the incident's current status.",
+							Computed:            true,
+						},
+						"incident_status_category": schema.StringAttribute{
+							MarkdownDescription: "The category of the incident's current status, such as `live` or `closed`.",
+							Computed:            true,
+						},
+						"severity_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the incident's severity, if it has one.",
+							Computed:            true,
+						},
+						"incident_type_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the incident's type, if it has one.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the incident was created, in RFC3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentIncidentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentIncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentIncidentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := buildIncidentFilters(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The generated client can't encode filters like status_category[one_of]=closed,
+	// so we add them to the query ourselves.
+	addFilters := func(ctx context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		for key, values := range filters {
+			query[key] = values
+		}
+		req.URL.RawQuery = query.Encode()
+
+		return nil
+	}
+
+	data.TotalCount = types.Int64Null()
+	incidents, err := paginate(ctx, data.Limit, data.After, func(ctx context.Context, pageSize int64, after *string) ([]client.IncidentV2, *string, error) {
+		result, err := i.client.IncidentsV2ListWithResponse(ctx, &client.IncidentsV2ListParams{
+			PageSize: &pageSize,
+			After:    after,
+		}, addFilters)
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var next *string
+		if meta := result.JSON200.PaginationMeta; meta != nil {
+			next = meta.After
+			if meta.TotalRecordCount != nil {
+				data.TotalCount = types.Int64Value(*meta.TotalRecordCount)
+			}
+		}
+
+		return result.JSON200.Incidents, next, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incidents, got error: %s", err))
+		return
+	}
+
+	data.Incidents = lo.Map(incidents, func(incident client.IncidentV2, _ int) IncidentIncidentsDataSourceEntry {
+		model := new(IncidentIncidentResource).buildModel(incident)
+
+		return IncidentIncidentsDataSourceEntry{
+			ID:                     model.ID,
+			Reference:              model.Reference,
+			Name:                   model.Name,
+			Mode:                   model.Mode,
+			Permalink:              model.Permalink,
+			IncidentStatusID:       model.IncidentStatusID,
+			IncidentStatusCategory: types.StringValue(string(incident.IncidentStatus.Category)),
+			SeverityID:             model.SeverityID,
+			IncidentTypeID:         model.IncidentTypeID,
+			CreatedAt:              types.StringValue(incident.CreatedAt.Format(time.RFC3339)),
+		}
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildIncidentFilters converts the data source's filters into the query parameters
+// the List Incidents API expects, such as severity[one_of]=<id>.
+func buildIncidentFilters(ctx context.Context, data IncidentIncidentsDataSourceModel) (url.Values, diag.Diagnostics) {
+	var (
+		filters = url.Values{}
+		diags   diag.Diagnostics
+	)
+
+	for key, set := range map[string]types.Set{
+		"status_category[one_of]": data.StatusCategory,
+		"severity[one_of]":        data.SeverityID,
+		"incident_type[one_of]":   data.IncidentTypeID,
+		"mode[one_of]":            data.Mode,
+	} {
+		values, elementDiags := stringElements(ctx, set)
+		diags.Append(elementDiags...)
+		if len(values) > 0 {
+			filters[key] = values
+		}
+	}
+
+	if !data.MinimumSeverityID.IsNull() {
+		filters.Set("severity[gte]", data.MinimumSeverityID.ValueString())
+	}
+	if !data.CreatedAfter.IsNull() {
+		filters.Set("created_at[gte]", data.CreatedAfter.ValueString())
+	}
+
+	return filters, diags
+}
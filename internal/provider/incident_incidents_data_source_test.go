@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestBuildIncidentFilters(t *testing.T) {
+	filters, diags := buildIncidentFilters(context.Background(), IncidentIncidentsDataSourceModel{
+		StatusCategory:    stringSetValue([]string{"active", "post-incident"}),
+		SeverityID:        types.SetNull(types.StringType),
+		MinimumSeverityID: types.StringValue("01GW2G3V0S59R238FAHPDS1R66"),
+		IncidentTypeID:    types.SetNull(types.StringType),
+		Mode:              stringSetValue([]string{}),
+		CreatedAfter:      types.StringValue("2024-01-01"),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := url.Values{
+		"status_category[one_of]": {"active", "post-incident"},
+		"severity[gte]":           {"01GW2G3V0S59R238FAHPDS1R66"},
+		"created_at[gte]":         {"2024-01-01"},
+	}
+	if !reflect.DeepEqual(filters, expected) {
+		t.Errorf("expected %v, got %v", expected, filters)
+	}
+}
+
+func TestAccIncidentIncidentsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentIncidentResourceConfig(nil) + `
+data "incident_incidents" "test" {
+  mode  = ["test"]
+  limit = 250
+
+  depends_on = [incident_incident.example]
+}
+
+data "incident_incidents" "declined" {
+  mode            = ["test"]
+  status_category = ["declined"]
+
+  depends_on = [incident_incident.example]
+}
+
+locals {
+  test_incident_ids     = [for incident in data.incident_incidents.test.incidents : incident.id]
+  declined_incident_ids = [for incident in data.incident_incidents.declined.incidents : incident.id]
+}
+
+output "listed" {
+  value = contains(local.test_incident_ids, incident_incident.example.id)
+}
+
+output "listed_as_declined" {
+  value = contains(local.declined_incident_ids, incident_incident.example.id)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("listed", "true"),
+					resource.TestCheckOutput("listed_as_declined", "false"),
+				),
+			},
+		},
+	})
+}
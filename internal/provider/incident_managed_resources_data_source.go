@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentManagedResourcesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentManagedResourcesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentManagedResourcesDataSource{}
+)
+
+func NewIncidentManagedResourcesDataSource() datasource.DataSource {
+	return &IncidentManagedResourcesDataSource{}
+}
+
+type IncidentManagedResourcesDataSource struct {
+	providerData *IncidentProviderData
+}
+
+type IncidentManagedResourcesDataSourceModel struct {
+	ResourceTypes types.Set         `tfsdk:"resource_types"`
+	Limit         types.Int64       `tfsdk:"limit"`
+	After         types.String      `tfsdk:"after"`
+	TotalCount    types.Int64       `tfsdk:"total_count"`
+	Resources     []ManagedResource `tfsdk:"resources"`
+	ManagedIDs    types.Set         `tfsdk:"managed_ids"`
+	UnmanagedIDs  types.Set         `tfsdk:"unmanaged_ids"`
+}
+
+type ManagedResource struct {
+	ID               types.String `tfsdk:"id"`
+	ResourceType     types.String `tfsdk:"resource_type"`
+	Name             types.String `tfsdk:"name"`
+	Managed          types.Bool   `tfsdk:"managed"`
+	TerraformVersion types.String `tfsdk:"terraform_version"`
+}
+
+func (i *IncidentManagedResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_resources"
+}
+
+func (i *IncidentManagedResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This data source lists catalog types, schedules and workflows, and whether each was
+created or imported by Terraform, according to the annotation Terraform records on
+them. Use it to report on what is and isn't under Terraform's control.
+
+Workflows are loaded one at a time to find their annotations, so this makes a request
+per workflow in your account unless ` + "`resource_types`" + ` excludes them.
+		`,
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"resource_types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only list objects of these types, from `catalog_type`, `schedule` and `workflow`. Defaults to all of them.",
+				Optional:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects of the chosen types, ordered by type and then name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the object.",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the object, one of `catalog_type`, `schedule` or `workflow`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the object.",
+							Computed:            true,
+						},
+						"managed": schema.BoolAttribute{
+							MarkdownDescription: "Whether the object was created or imported by Terraform.",
+							Computed:            true,
+						},
+						"terraform_version": schema.StringAttribute{
+							MarkdownDescription: "The version of Terraform that last managed the object, if it's managed.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"managed_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the objects in `resources` that are managed by Terraform.",
+				Computed:            true,
+			},
+			"unmanaged_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the objects in `resources` that aren't managed by Terraform.",
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (i *IncidentManagedResourcesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IncidentManagedResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ResourceTypes.IsUnknown() {
+		return
+	}
+
+	resourceTypes, diags := stringElements(ctx, data.ResourceTypes)
+	resp.Diagnostics.Append(diags...)
+	for _, resourceType := range resourceTypes {
+		if !lo.Contains(annotatedResourceTypes, resourceType) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("resource_types"),
+				"Unknown resource type",
+				fmt.Sprintf("resource_types must only contain %s, got %q.", strings.Join(annotatedResourceTypes, ", "), resourceType),
+			)
+		}
+	}
+}
+
+func (i *IncidentManagedResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.providerData = client
+}
+
+func (i *IncidentManagedResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentManagedResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceTypes := annotatedResourceTypes
+	if !data.ResourceTypes.IsNull() {
+		var diags diag.Diagnostics
+		resourceTypes, diags = stringElements(ctx, data.ResourceTypes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resources, err := listAnnotatedResources(ctx, i.providerData, resourceTypes, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list managed resources, got error: %s", err))
+		return
+	}
+
+	data.Resources = buildManagedResources(resources)
+	data.TotalCount = types.Int64Value(int64(len(data.Resources)))
+
+	data.Resources = paginateSlice(data.Resources, func(resource ManagedResource) string {
+		return resource.ID.ValueString()
+	}, data.Limit, data.After)
+	data.ManagedIDs = stringSetValue(lo.FilterMap(data.Resources, func(resource ManagedResource, _ int) (string, bool) {
+		return resource.ID.ValueString(), resource.Managed.ValueBool()
+	}))
+	data.UnmanagedIDs = stringSetValue(lo.FilterMap(data.Resources, func(resource ManagedResource, _ int) (string, bool) {
+		return resource.ID.ValueString(), !resource.Managed.ValueBool()
+	}))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildManagedResources records whether each object is managed by Terraform, ordered
+// by type and then name.
+func buildManagedResources(resources []annotatedResource) []ManagedResource {
+	resources = append([]annotatedResource{}, resources...)
+	sort.SliceStable(resources, func(a, b int) bool {
+		if resources[a].ResourceType != resources[b].ResourceType {
+			return resources[a].ResourceType < resources[b].ResourceType
+		}
+
+		return resources[a].Name < resources[b].Name
+	})
+
+	return lo.Map(resources, func(resource annotatedResource, _ int) ManagedResource {
+		version, managed := resource.Annotations[terraformVersionAnnotation]
+
+		result := ManagedResource{
+			ID:               types.StringValue(resource.ID),
+			ResourceType:     types.StringValue(resource.ResourceType),
+			Name:             types.StringValue(resource.Name),
+			Managed:          types.BoolValue(managed),
+			TerraformVersion: types.StringNull(),
+		}
+		if managed {
+			result.TerraformVersion = types.StringValue(version)
+		}
+
+		return result
+	})
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/samber/lo"
+)
+
+func TestBuildManagedResources(t *testing.T) {
+	resources := buildManagedResources([]annotatedResource{
+		{ResourceType: "workflow", ID: "workflow", Name: "Notify"},
+		{ResourceType: "catalog_type", ID: "team", Name: "Team", Annotations: map[string]string{
+			terraformVersionAnnotation: "1.5.0",
+		}},
+		{ResourceType: "catalog_type", ID: "service", Name: "Service"},
+	})
+
+	summary := lo.Map(resources, func(resource ManagedResource, _ int) string {
+		return fmt.Sprintf("%s:%v:%s", resource.ID.ValueString(), resource.Managed.ValueBool(), resource.TerraformVersion.ValueString())
+	})
+	if expected := []string{"service:false:", "team:true:1.5.0", "workflow:false:"}; !reflect.DeepEqual(summary, expected) {
+		t.Errorf("expected %v, got %v", expected, summary)
+	}
+	if !resources[0].TerraformVersion.IsNull() {
+		t.Errorf("expected unmanaged resources to have a null terraform_version")
+	}
+}
+
+func TestAccIncidentManagedResourcesDataSource(t *testing.T) {
+	typeName := generateTypeName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "incident_catalog_type" "example" {
+  name        = "Managed"
+  type_name   = %q
+  description = "Used to test listing managed resources."
+}
+
+data "incident_managed_resources" "catalog_types" {
+  resource_types = ["catalog_type"]
+
+  depends_on = [incident_catalog_type.example]
+}
+
+data "incident_managed_resources" "first_catalog_type" {
+  resource_types = ["catalog_type"]
+  limit          = 1
+
+  depends_on = [incident_catalog_type.example]
+}
+
+output "example_managed" {
+  value = contains(data.incident_managed_resources.catalog_types.managed_ids, incident_catalog_type.example.id)
+}
+`, typeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.incident_managed_resources.catalog_types", "resources.*", map[string]string{
+							"name":          "Managed",
+							"resource_type": "catalog_type",
+							"managed":       "true",
+						}),
+					resource.TestCheckOutput("example_managed", "true"),
+					resource.TestCheckResourceAttr(
+						"data.incident_managed_resources.first_catalog_type", "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_managed_resources.first_catalog_type", "total_count",
+						"data.incident_managed_resources.catalog_types", "total_count"),
+				),
+			},
+			{
+				Config: `
+data "incident_managed_resources" "invalid" {
+  resource_types = ["severity"]
+}
+`,
+				ExpectError: regexp.MustCompile("resource_types must only contain"),
+			},
+		},
+	})
+}
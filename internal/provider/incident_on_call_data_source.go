@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentOnCallDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentOnCallDataSource{}
+)
+
+func NewIncidentOnCallDataSource() datasource.DataSource {
+	return &IncidentOnCallDataSource{}
+}
+
+type IncidentOnCallDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentOnCallDataSourceModel struct {
+	ScheduleID types.String                 `tfsdk:"schedule_id"`
+	RotationID types.String                 `tfsdk:"rotation_id"`
+	Shifts     []IncidentScheduleShiftModel `tfsdk:"shifts"`
+}
+
+func (i *IncidentOnCallDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_on_call"
+}
+
+func (i *IncidentOnCallDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source returns who is currently on call for a schedule, and when their shift ends. Use it to pass the current on-call to other providers, such as making them a code owner.\n\nThe result changes whenever the schedule hands over, so expect it to differ between plans, and avoid using it for anything that's disruptive to replace.",
+		Attributes: map[string]schema.Attribute{
+			"schedule_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "id"),
+				Required:            true,
+			},
+			"rotation_id": schema.StringAttribute{
+				MarkdownDescription: "Only return shifts for the rotation with this ID, such as the primary rotation of a schedule that also has a secondary.",
+				Optional:            true,
+			},
+			"shifts": schema.ListNestedAttribute{
+				MarkdownDescription: "The shifts happening now, ordered by when they end, so the first is the next to hand over. Shifts with nobody on call have no user.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleShiftAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (i *IncidentOnCallDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyOnCall)
+}
+
+func (i *IncidentOnCallDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentOnCallDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.SchedulesV2ShowWithResponse(ctx, data.ScheduleID.ValueString())
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
+		return
+	}
+
+	shifts := lo.FromPtr(result.JSON200.Schedule.CurrentShifts)
+	if !data.RotationID.IsNull() {
+		shifts = lo.Filter(shifts, func(shift client.ScheduleEntryV2, _ int) bool {
+			return lo.FromPtr(shift.RotationId) == data.RotationID.ValueString()
+		})
+	}
+	data.Shifts = buildScheduleShifts(shifts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentOnCallDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentScheduleResourceConfig(&client.ScheduleV2{
+					Name: "Terraform on-call data source",
+				}) + `
+data "incident_on_call" "example" {
+  schedule_id = incident_schedule.example.id
+}
+
+data "incident_on_call" "other_rotation" {
+  schedule_id = incident_schedule.example.id
+  rotation_id = "rota-that-does-not-exist"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.incident_on_call.example", "shifts.#"),
+					resource.TestCheckResourceAttr(
+						"data.incident_on_call.other_rotation", "shifts.#", "0"),
+				),
+			},
+		},
+	})
+}
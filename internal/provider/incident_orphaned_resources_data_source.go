@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// terraformVersionAnnotation is set on every object we create, recording the version
+// of Terraform that last managed it.
+const terraformVersionAnnotation = "incident.io/terraform/version"
+
+var (
+	_ datasource.DataSource              = &IncidentOrphanedResourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentOrphanedResourcesDataSource{}
+)
+
+func NewIncidentOrphanedResourcesDataSource() datasource.DataSource {
+	return &IncidentOrphanedResourcesDataSource{}
+}
+
+type IncidentOrphanedResourcesDataSource struct {
+	providerData *IncidentProviderData
+}
+
+type IncidentOrphanedResourcesDataSourceModel struct {
+	ManagedIDs types.Set          `tfsdk:"managed_ids"`
+	Limit      types.Int64        `tfsdk:"limit"`
+	After      types.String       `tfsdk:"after"`
+	TotalCount types.Int64        `tfsdk:"total_count"`
+	Resources  []OrphanedResource `tfsdk:"resources"`
+}
+
+type OrphanedResource struct {
+	ID               types.String `tfsdk:"id"`
+	ResourceType     types.String `tfsdk:"resource_type"`
+	Name             types.String `tfsdk:"name"`
+	TerraformVersion types.String `tfsdk:"terraform_version"`
+}
+
+func (i *IncidentOrphanedResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orphaned_resources"
+}
+
+func (i *IncidentOrphanedResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This data source lists catalog types, schedules and workflows that were created by
+Terraform, but aren't in the list of IDs you're currently managing. Use it to find
+objects left behind by deleted workspaces or stacks, so they can be imported or
+cleaned up.
+
+Workflows are loaded one at a time to find their annotations, so this makes a request
+per workflow in your account.
+		`,
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"managed_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of all the objects currently managed by Terraform, across all your workspaces.",
+				Required:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Objects created by Terraform whose ID isn't in `managed_ids`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the object.",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the object, one of `catalog_type`, `schedule` or `workflow`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the object.",
+							Computed:            true,
+						},
+						"terraform_version": schema.StringAttribute{
+							MarkdownDescription: "The version of Terraform that last managed the object.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentOrphanedResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.providerData = client
+}
+
+func (i *IncidentOrphanedResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentOrphanedResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managedIDs, diags := stringElements(ctx, data.ManagedIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No need to load workflows we already know are managed.
+	resources, err := listAnnotatedResources(ctx, i.providerData, annotatedResourceTypes, func(id string) bool {
+		return lo.Contains(managedIDs, id)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find orphaned resources, got error: %s", err))
+		return
+	}
+
+	data.Resources = lo.FilterMap(resources, func(resource annotatedResource, _ int) (OrphanedResource, bool) {
+		if lo.Contains(managedIDs, resource.ID) {
+			return OrphanedResource{}, false
+		}
+
+		return buildOrphanedResource(resource.ResourceType, resource.ID, resource.Name, resource.Annotations)
+	})
+	data.TotalCount = types.Int64Value(int64(len(data.Resources)))
+
+	data.Resources = paginateSlice(data.Resources, func(resource OrphanedResource) string {
+		return resource.ID.ValueString()
+	}, data.Limit, data.After)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildOrphanedResource returns the object as a candidate orphan if it was created by
+// Terraform, or false if it wasn't.
+func buildOrphanedResource(resourceType, id, name string, annotations map[string]string) (OrphanedResource, bool) {
+	version, ok := annotations[terraformVersionAnnotation]
+	if !ok {
+		return OrphanedResource{}, false
+	}
+
+	return OrphanedResource{
+		ID:               types.StringValue(id),
+		ResourceType:     types.StringValue(resourceType),
+		Name:             types.StringValue(name),
+		TerraformVersion: types.StringValue(version),
+	}, true
+}
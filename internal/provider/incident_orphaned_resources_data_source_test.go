@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIncidentOrphanedResourcesDataSource(t *testing.T) {
+	typeName := generateTypeName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the type, then look for orphans without saying we manage it
+			{
+				Config: testAccIncidentOrphanedResourcesDataSourceConfig(orphanedResourcesDataSourceFixture{
+					TypeName: typeName,
+					Managed:  false,
+				}),
+				Check: testCheckOrphanedResource("incident_catalog_type.example", true),
+			},
+			// Once it's in managed_ids, it's no longer an orphan
+			{
+				Config: testAccIncidentOrphanedResourcesDataSourceConfig(orphanedResourcesDataSourceFixture{
+					TypeName: typeName,
+					Managed:  true,
+				}),
+				Check: testCheckOrphanedResource("incident_catalog_type.example", false),
+			},
+		},
+	})
+}
+
+// testCheckOrphanedResource checks whether the given resource is listed by the
+// orphaned resources data source.
+func testCheckOrphanedResource(resourceName string, orphaned bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id := s.RootModule().Resources[resourceName].Primary.ID
+		check := resource.TestCheckTypeSetElemNestedAttrs(
+			"data.incident_orphaned_resources.orphans", "resources.*", map[string]string{
+				"id":            id,
+				"resource_type": "catalog_type",
+			})
+
+		err := check(s)
+		if orphaned {
+			return err
+		}
+		if err == nil {
+			return fmt.Errorf("expected %s not to be listed as an orphan", id)
+		}
+
+		return nil
+	}
+}
+
+var orphanedResourcesDataSourceTemplate = template.Must(template.New("incident_orphaned_resources_data_source").Funcs(sprig.TxtFuncMap()).Parse(`
+resource "incident_catalog_type" "example" {
+  name        = "Orphan"
+  type_name   = {{ quote .TypeName }}
+  description = "Used to test finding orphaned resources."
+}
+
+data "incident_orphaned_resources" "orphans" {
+  managed_ids = [{{ if .Managed }}incident_catalog_type.example.id{{ end }}]
+
+  depends_on = [incident_catalog_type.example]
+}
+`))
+
+type orphanedResourcesDataSourceFixture struct {
+	TypeName string
+	Managed  bool
+}
+
+func testAccIncidentOrphanedResourcesDataSourceConfig(payload orphanedResourcesDataSourceFixture) string {
+	var buf bytes.Buffer
+	if err := orphanedResourcesDataSourceTemplate.Execute(&buf, payload); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
@@ -86,7 +86,7 @@ func (r *IncidentRoleResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilySettings)
 }
 
 func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -103,7 +103,7 @@ func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRe
 		Shortform:    data.Shortform.ValueString(),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident role, got error: %s", err))
@@ -111,7 +111,7 @@ func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident role resource with id=%s", result.JSON201.IncidentRole.Id))
-	data = r.buildModel(result.JSON201.IncidentRole)
+	data = r.buildModel(result.JSON201.IncidentRole, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -123,12 +123,15 @@ func (r *IncidentRoleResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	result, err := r.client.IncidentRolesV2ShowWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident role, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentRole)
+	data = r.buildModel(result.JSON200.IncidentRole, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -146,14 +149,14 @@ func (r *IncidentRoleResource) Update(ctx context.Context, req resource.UpdateRe
 		Shortform:    data.Shortform.ValueString(),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident role, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentRole)
+	data = r.buildModel(result.JSON200.IncidentRole, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -166,7 +169,7 @@ func (r *IncidentRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	result, err := r.client.IncidentRolesV2DeleteWithResponse(ctx, data.ID.ValueString())
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete incident role, got error: %s", err))
@@ -178,12 +181,15 @@ func (r *IncidentRoleResource) ImportState(ctx context.Context, req resource.Imp
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *IncidentRoleResource) buildModel(role client.IncidentRoleV2) *IncidentRoleResourceModel {
+// buildModel generates a terraform model from the API response, keeping the
+// previous description and instructions if they only differ from the API in
+// formatting.
+func (r *IncidentRoleResource) buildModel(role client.IncidentRoleV2, previous *IncidentRoleResourceModel) *IncidentRoleResourceModel {
 	return &IncidentRoleResourceModel{
 		ID:           types.StringValue(role.Id),
 		Name:         types.StringValue(role.Name),
-		Description:  types.StringValue(role.Description),
-		Instructions: types.StringValue(role.Instructions),
+		Description:  preserveRichText(previous.Description, role.Description),
+		Instructions: preserveRichText(previous.Instructions, role.Instructions),
 		Shortform:    types.StringValue(role.Shortform),
 	}
 }
@@ -47,6 +47,16 @@ func TestAccIncidentRoleResource(t *testing.T) {
 						"incident_incident_role.example", "name", "Communications Follow"),
 				),
 			},
+			// Markdown instructions, which shouldn't produce a diff after apply
+			{
+				Config: testAccIncidentRoleResourceConfig(&client.IncidentRoleV2{
+					Instructions: "Keep everyone updated:\n\n* Post in the incident channel\n* Update the status page\n",
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_incident_role.example", "instructions", "Keep everyone updated:\n\n* Post in the incident channel\n* Update the status page\n"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentScheduleDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentScheduleDataSource{}
+)
+
+func NewIncidentScheduleDataSource() datasource.DataSource {
+	return &IncidentScheduleDataSource{}
+}
+
+type IncidentScheduleDataSource struct {
+	client    *client.ClientWithResponses
+	dashboard *DashboardURL
+}
+
+type IncidentScheduleDataSourceModel struct {
+	ID            types.String                 `tfsdk:"id"`
+	Name          types.String                 `tfsdk:"name"`
+	Timezone      types.String                 `tfsdk:"timezone"`
+	AppURL        types.String                 `tfsdk:"app_url"`
+	CurrentShifts []IncidentScheduleShiftModel `tfsdk:"current_shifts"`
+}
+
+type IncidentScheduleShiftModel struct {
+	UserID     types.String `tfsdk:"user_id"`
+	UserName   types.String `tfsdk:"user_name"`
+	UserEmail  types.String `tfsdk:"user_email"`
+	RotationID types.String `tfsdk:"rotation_id"`
+	LayerID    types.String `tfsdk:"layer_id"`
+	StartAt    types.String `tfsdk:"start_at"`
+	EndAt      types.String `tfsdk:"end_at"`
+}
+
+// scheduleShiftAttributes describes a shift on a schedule, shared by every data
+// source that exposes who is on call.
+func scheduleShiftAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"user_id": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("UserV1ResponseBody", "id"),
+			Computed:            true,
+		},
+		"user_name": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("UserV1ResponseBody", "name"),
+			Computed:            true,
+		},
+		"user_email": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("UserV1ResponseBody", "email"),
+			Computed:            true,
+		},
+		"rotation_id": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("ScheduleEntryV2ResponseBody", "rotation_id"),
+			Computed:            true,
+		},
+		"layer_id": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("ScheduleEntryV2ResponseBody", "layer_id"),
+			Computed:            true,
+		},
+		"start_at": schema.StringAttribute{
+			MarkdownDescription: "When the shift started, in RFC3339 format.",
+			Computed:            true,
+		},
+		"end_at": schema.StringAttribute{
+			MarkdownDescription: "When the shift ends, in RFC3339 format.",
+			Computed:            true,
+		},
+	}
+}
+
+func (i *IncidentScheduleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (i *IncidentScheduleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source looks up an on-call schedule by name, including who is currently on call. Use it to refer to schedules that aren't managed by this configuration, such as from escalation paths.\n\nThe current shifts change as people hand over, so expect them to differ between plans.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "id"),
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "name"),
+				Required:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "timezone"),
+				Computed:            true,
+			},
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this schedule in the incident.io dashboard.",
+				Computed:            true,
+			},
+			"current_shifts": schema.ListNestedAttribute{
+				MarkdownDescription: "The shifts happening now, ordered by when they end.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleShiftAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (i *IncidentScheduleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyOnCall)
+	i.dashboard = client.DashboardURL
+}
+
+func (i *IncidentScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentScheduleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, err := listSchedules(ctx, i.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list schedules, got error: %s", err))
+		return
+	}
+
+	matches := lo.Filter(schedules, func(schedule client.ScheduleV2, _ int) bool {
+		return schedule.Name == data.Name.ValueString()
+	})
+	if len(matches) != 1 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find schedule, got error: found %d schedules with name %q", len(matches), data.Name.ValueString()))
+		return
+	}
+	schedule := matches[0]
+
+	modelResp := IncidentScheduleDataSourceModel{
+		ID:            types.StringValue(schedule.Id),
+		Name:          types.StringValue(schedule.Name),
+		Timezone:      types.StringValue(schedule.Timezone),
+		AppURL:        i.dashboard.Build(ctx, "on-call", "schedules", schedule.Id),
+		CurrentShifts: buildScheduleShifts(lo.FromPtr(schedule.CurrentShifts)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
+
+// buildScheduleShifts converts schedule entries into shifts, ordered by when they end so
+// the first shift is the next to hand over.
+func buildScheduleShifts(entries []client.ScheduleEntryV2) []IncidentScheduleShiftModel {
+	entries = append([]client.ScheduleEntryV2{}, entries...)
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].EndAt.Before(entries[b].EndAt)
+	})
+
+	return lo.Map(entries, func(entry client.ScheduleEntryV2, _ int) IncidentScheduleShiftModel {
+		shift := IncidentScheduleShiftModel{
+			UserID:     types.StringNull(),
+			UserName:   types.StringNull(),
+			UserEmail:  types.StringNull(),
+			RotationID: types.StringPointerValue(entry.RotationId),
+			LayerID:    types.StringPointerValue(entry.LayerId),
+			StartAt:    types.StringValue(entry.StartAt.Format(time.RFC3339)),
+			EndAt:      types.StringValue(entry.EndAt.Format(time.RFC3339)),
+		}
+		if entry.User != nil {
+			shift.UserID = types.StringValue(entry.User.Id)
+			shift.UserName = types.StringValue(entry.User.Name)
+			shift.UserEmail = types.StringPointerValue(entry.User.Email)
+		}
+
+		return shift
+	})
+}
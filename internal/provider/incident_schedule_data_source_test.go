@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestBuildScheduleShifts(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	shifts := buildScheduleShifts([]client.ScheduleEntryV2{
+		{
+			StartAt:    now.Add(-time.Hour),
+			EndAt:      now.Add(2 * time.Hour),
+			RotationId: lo.ToPtr("secondary"),
+			User:       &client.UserV1{Id: "bob", Name: "Bob"},
+		},
+		{
+			StartAt:    now.Add(-time.Hour),
+			EndAt:      now.Add(time.Hour),
+			RotationId: lo.ToPtr("primary"),
+			User:       &client.UserV1{Id: "alice", Name: "Alice", Email: lo.ToPtr("alice@example.com")},
+		},
+		{
+			StartAt: now.Add(-time.Hour),
+			EndAt:   now.Add(3 * time.Hour),
+		},
+	})
+
+	userIDs := lo.Map(shifts, func(shift IncidentScheduleShiftModel, _ int) string {
+		return shift.UserID.ValueString()
+	})
+	if expected := []string{"alice", "bob", ""}; !reflect.DeepEqual(userIDs, expected) {
+		t.Errorf("expected shifts ordered by end, got %v", userIDs)
+	}
+	if !shifts[2].UserID.IsNull() {
+		t.Errorf("expected no user for an unfilled shift, got %v", shifts[2].UserID)
+	}
+	if shifts[0].UserEmail.ValueString() != "alice@example.com" || !shifts[1].UserEmail.IsNull() {
+		t.Errorf("expected emails to be set when known, got %v and %v", shifts[0].UserEmail, shifts[1].UserEmail)
+	}
+	if shifts[0].EndAt.ValueString() != "2024-05-01T10:00:00Z" {
+		t.Errorf("expected end_at in RFC3339, got %s", shifts[0].EndAt.ValueString())
+	}
+}
+
+func TestAccIncidentScheduleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentScheduleResourceConfig(&client.ScheduleV2{
+					Name: "Terraform schedule data source",
+				}) + `
+data "incident_schedule" "example" {
+  name = incident_schedule.example.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.incident_schedule.example", "id",
+						"incident_schedule.example", "id"),
+					resource.TestCheckResourceAttr(
+						"data.incident_schedule.example", "timezone", "Europe/London"),
+				),
+			},
+		},
+	})
+}
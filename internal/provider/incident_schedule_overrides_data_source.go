@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentScheduleOverridesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentScheduleOverridesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentScheduleOverridesDataSource{}
+)
+
+// scheduleOverridesDefaultWindow is how far ahead we look for overrides when
+// window_end isn't set.
+const scheduleOverridesDefaultWindow = 28 * 24 * time.Hour
+
+func NewIncidentScheduleOverridesDataSource() datasource.DataSource {
+	return &IncidentScheduleOverridesDataSource{}
+}
+
+type IncidentScheduleOverridesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentScheduleOverridesDataSourceModel struct {
+	ScheduleID  types.String                 `tfsdk:"schedule_id"`
+	WindowStart types.String                 `tfsdk:"window_start"`
+	WindowEnd   types.String                 `tfsdk:"window_end"`
+	Overrides   []IncidentScheduleShiftModel `tfsdk:"overrides"`
+	Shifts      []IncidentScheduleShiftModel `tfsdk:"shifts"`
+}
+
+func (i *IncidentScheduleOverridesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_overrides"
+}
+
+func (i *IncidentScheduleOverridesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source returns the current and upcoming overrides for a schedule, along with who will be on call once they're applied. Use it to check a schedule is covered over a period such as a holiday, before it starts.\n\nBy default this looks four weeks ahead from now, so expect the result to differ between plans.",
+		Attributes: map[string]schema.Attribute{
+			"schedule_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "id"),
+				Required:            true,
+			},
+			"window_start": schema.StringAttribute{
+				MarkdownDescription: "Only return overrides and shifts that end after this time, in RFC3339 format. Defaults to now.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"window_end": schema.StringAttribute{
+				MarkdownDescription: "Only return overrides and shifts that start before this time, in RFC3339 format. Defaults to four weeks after `window_start`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"overrides": schema.ListNestedAttribute{
+				MarkdownDescription: "The overrides in the window, ordered by when they end.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleShiftAttributes(),
+				},
+			},
+			"shifts": schema.ListNestedAttribute{
+				MarkdownDescription: "Who is on call throughout the window, with overrides applied, ordered by when their shift ends. Shifts with nobody on call have no user.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleShiftAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (i *IncidentScheduleOverridesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IncidentScheduleOverridesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WindowStart.IsUnknown() || data.WindowEnd.IsUnknown() {
+		return
+	}
+
+	if _, _, err := scheduleOverridesWindow(data.WindowStart, data.WindowEnd, time.Now()); err != nil {
+		resp.Diagnostics.AddError("Invalid window", err.Error())
+	}
+}
+
+func (i *IncidentScheduleOverridesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyOnCall)
+}
+
+func (i *IncidentScheduleOverridesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentScheduleOverridesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowStart, windowEnd, err := scheduleOverridesWindow(data.WindowStart, data.WindowEnd, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid window", err.Error())
+		return
+	}
+
+	result, err := i.client.SchedulesV2ListScheduleEntriesWithResponse(ctx, &client.SchedulesV2ListScheduleEntriesParams{
+		ScheduleId:       data.ScheduleID.ValueString(),
+		EntryWindowStart: &windowStart,
+		EntryWindowEnd:   &windowEnd,
+	})
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list schedule entries, got error: %s", err))
+		return
+	}
+
+	data.WindowStart = types.StringValue(windowStart.Format(time.RFC3339))
+	data.WindowEnd = types.StringValue(windowEnd.Format(time.RFC3339))
+	data.Overrides = buildScheduleShifts(result.JSON200.ScheduleEntries.Overrides)
+	data.Shifts = buildScheduleShifts(result.JSON200.ScheduleEntries.Final)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// scheduleOverridesWindow returns the window to list schedule entries for, defaulting
+// to the window starting now.
+func scheduleOverridesWindow(start, end types.String, now time.Time) (time.Time, time.Time, error) {
+	windowStart := now.UTC().Truncate(time.Second)
+	if !start.IsNull() {
+		var err error
+		windowStart, err = time.Parse(time.RFC3339, start.ValueString())
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.Wrap(err, "window_start must be in RFC3339 format")
+		}
+	}
+
+	windowEnd := windowStart.Add(scheduleOverridesDefaultWindow)
+	if !end.IsNull() {
+		var err error
+		windowEnd, err = time.Parse(time.RFC3339, end.ValueString())
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.Wrap(err, "window_end must be in RFC3339 format")
+		}
+	}
+
+	if !windowEnd.After(windowStart) {
+		return time.Time{}, time.Time{}, fmt.Errorf("window_end (%s) must be after window_start (%s)", windowEnd.Format(time.RFC3339), windowStart.Format(time.RFC3339))
+	}
+
+	return windowStart, windowEnd, nil
+}
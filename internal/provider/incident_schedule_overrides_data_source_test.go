@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestScheduleOverridesWindow(t *testing.T) {
+	now := time.Date(2024, 12, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		start, end    types.String
+		expectedStart time.Time
+		expectedEnd   time.Time
+		expectedError string
+	}{
+		{
+			name:          "defaults to four weeks from now",
+			start:         types.StringNull(),
+			end:           types.StringNull(),
+			expectedStart: now,
+			expectedEnd:   now.Add(28 * 24 * time.Hour),
+		},
+		{
+			name:          "defaults end to four weeks after start",
+			start:         types.StringValue("2024-12-20T00:00:00Z"),
+			end:           types.StringNull(),
+			expectedStart: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "uses start and end",
+			start:         types.StringValue("2024-12-24T00:00:00Z"),
+			end:           types.StringValue("2024-12-27T00:00:00Z"),
+			expectedStart: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2024, 12, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "rejects invalid timestamps",
+			start:         types.StringValue("2024-12-24"),
+			end:           types.StringNull(),
+			expectedError: "window_start must be in RFC3339 format",
+		},
+		{
+			name:          "rejects end before start",
+			start:         types.StringValue("2024-12-27T00:00:00Z"),
+			end:           types.StringValue("2024-12-24T00:00:00Z"),
+			expectedError: "must be after window_start",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := scheduleOverridesWindow(tt.start, tt.end, now)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !start.Equal(tt.expectedStart) || !end.Equal(tt.expectedEnd) {
+				t.Errorf("expected %s to %s, got %s to %s", tt.expectedStart, tt.expectedEnd, start, end)
+			}
+		})
+	}
+}
+
+func TestAccIncidentScheduleOverridesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentScheduleResourceConfig(&client.ScheduleV2{
+					Name: "Terraform schedule overrides data source",
+				}) + `
+data "incident_schedule_overrides" "example" {
+  schedule_id = incident_schedule.example.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.incident_schedule_overrides.example", "overrides.#", "0"),
+					resource.TestCheckResourceAttrSet(
+						"data.incident_schedule_overrides.example", "shifts.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.incident_schedule_overrides.example", "window_end"),
+				),
+			},
+			{
+				Config: `
+data "incident_schedule_overrides" "invalid" {
+  schedule_id  = "01HXYZ"
+  window_start = "2024-12-27T00:00:00Z"
+  window_end   = "2024-12-24T00:00:00Z"
+}
+`,
+				ExpectError: regexp.MustCompile("must be after window_start"),
+			},
+		},
+	})
+}
@@ -26,13 +26,37 @@ var (
 type IncidentScheduleResource struct {
 	client           *client.ClientWithResponses
 	terraformVersion string
+	dashboard        *DashboardURL
 }
 
 type IncidentScheduleResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Timezone  types.String `tfsdk:"timezone"`
-	Rotations []Rotation   `tfsdk:"rotations"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Timezone         types.String `tfsdk:"timezone"`
+	Rotations        []Rotation   `tfsdk:"rotations"`
+	ManagedRotations types.Set    `tfsdk:"managed_rotations"`
+	AppURL           types.String `tfsdk:"app_url"`
+}
+
+// checkManagedRotations ensures we only configure rotations we manage, as we'd
+// otherwise ignore them when reading the schedule back.
+func (m IncidentScheduleResourceModel) checkManagedRotations(managed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if managed == nil {
+		return diags
+	}
+
+	for _, rotation := range m.Rotations {
+		if !lo.Contains(managed, rotation.ID.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("rotations"),
+				"Unmanaged rotation",
+				fmt.Sprintf("Rotation %s is configured but is not in managed_rotations.", rotation.ID.ValueString()),
+			)
+		}
+	}
+
+	return diags
 }
 
 type Rotation struct {
@@ -79,6 +103,13 @@ func (r *IncidentScheduleResource) Schema(ctx context.Context, req resource.Sche
 	resp.Schema = schema.Schema{
 		MarkdownDescription: apischema.TagDocstring("Schedules V2"),
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this schedule in the incident.io dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -93,6 +124,11 @@ func (r *IncidentScheduleResource) Schema(ctx context.Context, req resource.Sche
 			"timezone": schema.StringAttribute{
 				Required: true,
 			},
+			"managed_rotations": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the rotations this resource manages. If set, any other rotations are left untouched, so they can be managed elsewhere, such as by `incident_schedule_rotation`. If not set, this resource manages all rotations.",
+				Optional:            true,
+			},
 			"rotations": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -104,74 +140,80 @@ func (r *IncidentScheduleResource) Schema(ctx context.Context, req resource.Sche
 							Required:            true,
 							MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "name"),
 						},
-						"versions": schema.ListNestedAttribute{
-							Required: true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"users": schema.ListAttribute{
-										Required:            true,
-										ElementType:         types.StringType,
-										MarkdownDescription: apischema.Docstring("UserReferencePayloadV1RequestBody", "id"),
-									},
-									"effective_from": schema.StringAttribute{
-										Optional:            true,
-										MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "effective_from"),
-									},
-									"handover_start_at": schema.StringAttribute{
-										Required:            true,
-										MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "handover_start_at"),
-									},
-									"working_intervals": schema.ListNestedAttribute{
-										Optional:            true,
-										MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "working_interval"),
-										NestedObject: schema.NestedAttributeObject{
-											Attributes: map[string]schema.Attribute{
-												"start": schema.StringAttribute{
-													Required: true,
-												},
-												"end": schema.StringAttribute{
-													Required: true,
-												},
-												"day": schema.StringAttribute{
-													Required: true,
-												},
-											},
-										},
-									},
-									"layers": schema.ListNestedAttribute{
-										Required:            true,
-										MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "layers"),
-										NestedObject: schema.NestedAttributeObject{
-											Attributes: map[string]schema.Attribute{
-												"id": schema.StringAttribute{
-													Required: true,
-												},
-												"name": schema.StringAttribute{
-													Required: true,
-												},
-											},
-										},
-									},
-									"handovers": schema.ListNestedAttribute{
-										Optional:            true,
-										MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "handovers"),
-										NestedObject: schema.NestedAttributeObject{
-											Attributes: map[string]schema.Attribute{
-												"interval": schema.Int64Attribute{
-													Required: true,
-												},
-												"interval_type": schema.StringAttribute{
-													Required: true,
-												},
-											},
-										},
-									},
-								},
+						"versions": scheduleRotationVersionsAttribute(),
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+}
+
+// scheduleRotationVersionsAttribute is shared by incident_schedule and
+// incident_schedule_rotation, which both manage the versions of a rotation.
+func scheduleRotationVersionsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Required: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"users": schema.ListAttribute{
+					Required:            true,
+					ElementType:         types.StringType,
+					MarkdownDescription: apischema.Docstring("UserReferencePayloadV1RequestBody", "id"),
+				},
+				"effective_from": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "effective_from"),
+				},
+				"handover_start_at": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "handover_start_at"),
+				},
+				"working_intervals": schema.ListNestedAttribute{
+					Optional:            true,
+					MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "working_interval"),
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"start": schema.StringAttribute{
+								Required: true,
+							},
+							"end": schema.StringAttribute{
+								Required: true,
+							},
+							"day": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				"layers": schema.ListNestedAttribute{
+					Required:            true,
+					MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "layers"),
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Required: true,
+							},
+							"name": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				"handovers": schema.ListNestedAttribute{
+					Optional:            true,
+					MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "handovers"),
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"interval": schema.Int64Attribute{
+								Required: true,
+							},
+							"interval_type": schema.StringAttribute{
+								Required: true,
 							},
 						},
 					},
 				},
-				Required: true,
 			},
 		},
 	}
@@ -192,7 +234,8 @@ func (r *IncidentScheduleResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyOnCall)
+	r.dashboard = client.DashboardURL
 	r.terraformVersion = client.TerraformVersion
 }
 
@@ -203,6 +246,13 @@ func (r *IncidentScheduleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	managed, diags := stringElements(ctx, data.ManagedRotations)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(data.checkManagedRotations(managed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	rotationArray, err := buildScheduleCreatePayload(data, resp)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule, got error: %s", err))
@@ -212,7 +262,7 @@ func (r *IncidentScheduleResource) Create(ctx context.Context, req resource.Crea
 	result, err := r.client.SchedulesV2CreateWithResponse(ctx, client.SchedulesV2CreateJSONRequestBody{
 		Schedule: client.ScheduleCreatePayloadV2{
 			Annotations: &map[string]string{
-				"incident.io/terraform/version": r.terraformVersion,
+				terraformVersionAnnotation: r.terraformVersion,
 			},
 			Name:     data.Name.ValueStringPointer(),
 			Timezone: data.Timezone.ValueStringPointer(),
@@ -222,7 +272,7 @@ func (r *IncidentScheduleResource) Create(ctx context.Context, req resource.Crea
 		},
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule, got error: %s", err))
@@ -230,7 +280,8 @@ func (r *IncidentScheduleResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident schedule resource with id=%s", result.JSON201.Schedule.Id))
-	data = r.buildModel(result.JSON201.Schedule)
+	data = r.buildModel(result.JSON201.Schedule, managed)
+	data.AppURL = r.dashboard.Build(ctx, "on-call", "schedules", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -241,6 +292,12 @@ func (r *IncidentScheduleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	managed, diags := stringElements(ctx, data.ManagedRotations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := r.client.SchedulesV2ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
@@ -253,7 +310,13 @@ func (r *IncidentScheduleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	data = r.buildModel(result.JSON200.Schedule)
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.Schedule, managed)
+	data.AppURL = r.dashboard.Build(ctx, "on-call", "schedules", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -264,7 +327,35 @@ func (r *IncidentScheduleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	rotationArray, err := buildScheduleUpdatePayload(old, resp)
+	managed, diags := stringElements(ctx, old.ManagedRotations)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(old.checkManagedRotations(managed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := *old
+	if managed != nil {
+		// Preserve any rotations we don't manage, as the API replaces all rotations at
+		// once.
+		unlock := lockObject(old.ID.ValueString())
+		defer unlock()
+
+		existing, err := r.client.SchedulesV2ShowWithResponse(ctx, old.ID.ValueString())
+		if err == nil && existing.StatusCode() >= 400 {
+			err = clientError(existing.StatusCode(), existing.Body)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
+			return
+		}
+
+		payload.Rotations = append(payload.Rotations, lo.Filter(r.buildModel(existing.JSON200.Schedule, nil).Rotations, func(rotation Rotation, _ int) bool {
+			return !lo.Contains(managed, rotation.ID.ValueString())
+		})...)
+	}
+
+	rotationArray, err := buildScheduleUpdatePayload(&payload, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule, got error: %s", err))
 		return
@@ -273,7 +364,7 @@ func (r *IncidentScheduleResource) Update(ctx context.Context, req resource.Upda
 	result, err := r.client.SchedulesV2UpdateWithResponse(ctx, old.ID.ValueString(), client.SchedulesV2UpdateJSONRequestBody{
 		Schedule: client.ScheduleUpdatePayloadV2{
 			Annotations: &map[string]string{
-				"incident.io/terraform/version": r.terraformVersion,
+				terraformVersionAnnotation: r.terraformVersion,
 			},
 			Name:     old.Name.ValueStringPointer(),
 			Timezone: old.Timezone.ValueStringPointer(),
@@ -283,14 +374,15 @@ func (r *IncidentScheduleResource) Update(ctx context.Context, req resource.Upda
 		},
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule, got error: %s", err))
 		return
 	}
 
-	old = r.buildModel(result.JSON200.Schedule)
+	old = r.buildModel(result.JSON200.Schedule, managed)
+	old.AppURL = r.dashboard.Build(ctx, "on-call", "schedules", old.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &old)...)
 }
 
@@ -301,7 +393,10 @@ func (r *IncidentScheduleResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, err := r.client.SchedulesV2DestroyWithResponse(ctx, data.ID.ValueString())
+	result, err := r.client.SchedulesV2DestroyWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schedule, got error: %s", err))
 		return
@@ -359,7 +454,7 @@ func buildScheduleCreatePayload(data *IncidentScheduleResourceModel, resp *resou
 	return rotationArray, nil
 }
 
-func buildScheduleUpdatePayload(data *IncidentScheduleResourceModel, resp *resource.UpdateResponse) ([]client.ScheduleRotationUpdatePayloadV2, error) {
+func buildScheduleUpdatePayload(data *IncidentScheduleResourceModel, diags *diag.Diagnostics) ([]client.ScheduleRotationUpdatePayloadV2, error) {
 	rotationArray := make([]client.ScheduleRotationUpdatePayloadV2, 0, len(data.Rotations))
 	for _, rotation := range data.Rotations {
 		for _, version := range rotation.Versions {
@@ -383,11 +478,11 @@ func buildScheduleUpdatePayload(data *IncidentScheduleResourceModel, resp *resou
 
 			handoverStartAt, err := time.Parse(time.RFC3339, version.HandoverStartAt.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule, handover start in invalid format: %s", err))
+				diags.AddError("Client Error", fmt.Sprintf("Unable to create schedule, handover start in invalid format: %s", err))
 				return nil, err
 			}
 
-			effectiveFrom := buildEffectiveFrom(resp.Diagnostics, version.EffectiveFrom)
+			effectiveFrom := buildEffectiveFrom(*diags, version.EffectiveFrom)
 			handovers := buildHandoversArray(version.Handovers)
 			users := buildUsersArray(version.Users)
 
@@ -445,7 +540,8 @@ func buildEffectiveFrom(diagnostics diag.Diagnostics, effectiveFrom types.String
 // buildModel converts a schedule from the API to a resource model
 // this involves taking schedule rotations, grouping them by ID,
 // extracting the shared data, and then building the nested structure.
-func (r *IncidentScheduleResource) buildModel(schedule client.ScheduleV2) *IncidentScheduleResourceModel {
+// If managed is non-nil, only those rotations are included.
+func (r *IncidentScheduleResource) buildModel(schedule client.ScheduleV2, managed []string) *IncidentScheduleResourceModel {
 	rotationsGroupedByID := lo.GroupBy(schedule.Config.Rotations, func(rotation client.ScheduleRotationV2) string {
 		return rotation.Id
 	})
@@ -463,11 +559,22 @@ func (r *IncidentScheduleResource) buildModel(schedule client.ScheduleV2) *Incid
 	})
 
 	rotationNames = lo.Uniq(rotationNames)
+	if managed != nil {
+		rotationNames = lo.Filter(rotationNames, func(rotation RotationName, _ int) bool {
+			return lo.Contains(managed, rotation.ID)
+		})
+	}
+
+	managedRotations := types.SetNull(types.StringType)
+	if managed != nil {
+		managedRotations = stringSetValue(managed)
+	}
 
 	return &IncidentScheduleResourceModel{
-		Name:     types.StringValue(schedule.Name),
-		ID:       types.StringValue(schedule.Id),
-		Timezone: types.StringValue(schedule.Timezone),
+		Name:             types.StringValue(schedule.Name),
+		ID:               types.StringValue(schedule.Id),
+		Timezone:         types.StringValue(schedule.Timezone),
+		ManagedRotations: managedRotations,
 		Rotations: lo.Map(rotationNames, func(rotation RotationName, _ int) Rotation {
 			newRotation := Rotation{
 				ID:   types.StringValue(rotation.ID),
@@ -530,3 +637,26 @@ func (r *IncidentScheduleResource) buildModel(schedule client.ScheduleV2) *Incid
 		}),
 	}
 }
+
+// listSchedules loads every schedule in the organisation, following pagination.
+func listSchedules(ctx context.Context, apiClient *client.ClientWithResponses) ([]client.ScheduleV2, error) {
+	return paginate(ctx, types.Int64Null(), types.StringNull(), func(ctx context.Context, pageSize int64, after *string) ([]client.ScheduleV2, *string, error) {
+		result, err := apiClient.SchedulesV2ListWithResponse(ctx, &client.SchedulesV2ListParams{
+			PageSize: &pageSize,
+			After:    after,
+		})
+		if err == nil && result.StatusCode() >= 400 {
+			err = clientError(result.StatusCode(), result.Body)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var next *string
+		if result.JSON200.PaginationMeta != nil {
+			next = result.JSON200.PaginationMeta.After
+		}
+
+		return result.JSON200.Schedules, next, nil
+	})
+}
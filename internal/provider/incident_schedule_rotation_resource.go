@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ resource.Resource                = &IncidentScheduleRotationResource{}
+	_ resource.ResourceWithImportState = &IncidentScheduleRotationResource{}
+)
+
+type IncidentScheduleRotationResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentScheduleRotationResourceModel struct {
+	ID         types.String      `tfsdk:"id"`
+	ScheduleID types.String      `tfsdk:"schedule_id"`
+	RotationID types.String      `tfsdk:"rotation_id"`
+	Name       types.String      `tfsdk:"name"`
+	Versions   []RotationVersion `tfsdk:"versions"`
+}
+
+func NewIncidentScheduleRotationResource() resource.Resource {
+	return &IncidentScheduleRotationResource{}
+}
+
+func (r *IncidentScheduleRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_rotation"
+}
+
+func (r *IncidentScheduleRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This resource manages a single rotation of a schedule, leaving the schedule's other
+rotations alone. Use it for large schedules where different teams own different
+rotations.
+
+Changing a rotation reads the whole schedule and writes it back with that rotation
+updated. Changes within one apply are made one at a time, but the API can't detect
+concurrent writes, so applies from separate Terraform states that change rotations of
+the same schedule at the same time can revert each other's changes. If rotations of a
+schedule are managed from several states, don't run their applies concurrently.
+
+If the schedule itself is managed by ` + "`incident_schedule`" + `, set ` + "`managed_rotations`" + ` on
+that resource so it doesn't remove rotations managed by this resource.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this rotation, in the form `schedule_id:rotation_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the schedule this rotation belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "id"),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ScheduleRotationV2ResponseBody", "name"),
+				Required:            true,
+			},
+			"versions": scheduleRotationVersionsAttribute(),
+		},
+	}
+}
+
+func (r *IncidentScheduleRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.ClientFor(apiKeyFamilyOnCall)
+}
+
+func (r *IncidentScheduleRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentScheduleRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, diags := r.setRotation(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = r.buildModel(*schedule, data.RotationID.ValueString())
+	if data == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to find rotation in schedule after saving it")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentScheduleRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentScheduleRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.SchedulesV2ShowWithResponse(ctx, data.ScheduleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
+		return
+	}
+
+	if result.StatusCode() == 404 {
+		resp.Diagnostics.AddWarning("Not Found", fmt.Sprintf("Unable to read schedule, got status code: %d", result.StatusCode()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.Schedule, data.RotationID.ValueString())
+	if data == nil {
+		resp.Diagnostics.AddWarning("Not Found", "Unable to find rotation in schedule, it may have been removed outside of Terraform")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentScheduleRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentScheduleRotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, diags := r.setRotation(ctx, data, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data = r.buildModel(*schedule, data.RotationID.ValueString())
+	if data == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to find rotation in schedule after saving it")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IncidentScheduleRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentScheduleRotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.setRotation(ctx, data, true)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *IncidentScheduleRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scheduleID, rotationID, found := strings.Cut(req.ID, ":")
+	if !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: schedule_id:rotation_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), scheduleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rotation_id"), rotationID)...)
+}
+
+// setRotation writes our rotation into the schedule, or removes it if remove is true,
+// preserving all the schedule's other rotations. The lock only serialises writes from
+// this process: writes from other processes between our read and update are lost.
+func (r *IncidentScheduleRotationResource) setRotation(ctx context.Context, data *IncidentScheduleRotationResourceModel, remove bool) (*client.ScheduleV2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	unlock := lockObject(data.ScheduleID.ValueString())
+	defer unlock()
+
+	existing, err := r.client.SchedulesV2ShowWithResponse(ctx, data.ScheduleID.ValueString())
+	if err == nil && existing.StatusCode() >= 400 {
+		if remove && existing.StatusCode() == 404 {
+			return nil, diags // the schedule has gone, and our rotation with it
+		}
+		err = clientError(existing.StatusCode(), existing.Body)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
+		return nil, diags
+	}
+
+	model := new(IncidentScheduleResource).buildModel(existing.JSON200.Schedule, nil)
+	model.Rotations = lo.Filter(model.Rotations, func(rotation Rotation, _ int) bool {
+		return rotation.ID.ValueString() != data.RotationID.ValueString()
+	})
+	if !remove {
+		model.Rotations = append(model.Rotations, Rotation{
+			ID:       data.RotationID,
+			Name:     data.Name,
+			Versions: data.Versions,
+		})
+	}
+
+	rotationArray, err := buildScheduleUpdatePayload(model, &diags)
+	if err != nil {
+		return nil, diags
+	}
+
+	result, err := r.client.SchedulesV2UpdateWithResponse(ctx, data.ScheduleID.ValueString(), client.SchedulesV2UpdateJSONRequestBody{
+		Schedule: client.ScheduleUpdatePayloadV2{
+			Config: &client.ScheduleConfigUpdatePayloadV2{
+				Rotations: &rotationArray,
+			},
+		},
+	})
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update schedule rotation, got error: %s", err))
+		return nil, diags
+	}
+
+	return &result.JSON200.Schedule, diags
+}
+
+// buildModel returns the model for our rotation in the schedule, or nil if the
+// schedule no longer has it.
+func (r *IncidentScheduleRotationResource) buildModel(schedule client.ScheduleV2, rotationID string) *IncidentScheduleRotationResourceModel {
+	rotation, found := lo.Find(new(IncidentScheduleResource).buildModel(schedule, []string{rotationID}).Rotations, func(rotation Rotation) bool {
+		return rotation.ID.ValueString() == rotationID
+	})
+	if !found {
+		return nil
+	}
+
+	return &IncidentScheduleRotationResourceModel{
+		ID:         types.StringValue(schedule.Id + ":" + rotationID),
+		ScheduleID: types.StringValue(schedule.Id),
+		RotationID: rotation.ID,
+		Name:       rotation.Name,
+		Versions:   rotation.Versions,
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestAccIncidentScheduleRotationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentScheduleRotationResourceConfig("Secondary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_schedule_rotation.secondary", "name", "Secondary"),
+					resource.TestCheckResourceAttr(
+						"incident_schedule.example", "rotations.#", "1"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_schedule_rotation.secondary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and read
+			{
+				Config: testAccIncidentScheduleRotationResourceConfig("Backup"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_schedule_rotation.secondary", "name", "Backup"),
+					resource.TestCheckResourceAttr(
+						"incident_schedule.example", "rotations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIncidentScheduleRotationResourceConfig(name string) string {
+	schedule := incidentScheduleDefault()
+
+	// The schedule only manages its primary rotation, leaving the secondary rotation to
+	// incident_schedule_rotation.
+	config := generateScheduleTerraform("Schedule Rotation Acceptance Test", &schedule)
+	config = strings.Replace(config, "  timezone", "  managed_rotations = [\"rota-primary\"]\n  timezone", 1)
+
+	config += "\nresource \"incident_schedule_rotation\" \"secondary\" {\n"
+	config += "  schedule_id = incident_schedule.example.id\n"
+	config += "  rotation_id = \"rota-secondary\"\n"
+	config += "  name        = " + quote(name) + "\n"
+	config += "  versions    = " + generateVersionsArray([]client.ScheduleRotationV2{
+		{
+			HandoverStartAt: time.Date(2024, 4, 26, 16, 0, 0, 0, time.UTC),
+			Handovers: []client.ScheduleRotationHandoverV2{
+				{
+					IntervalType: lo.ToPtr(client.ScheduleRotationHandoverV2IntervalType("daily")),
+					Interval:     lo.ToPtr(int64(1)),
+				},
+			},
+			Layers: []client.ScheduleLayerV2{
+				{
+					Id:   lo.ToPtr("rota-secondary-layer-one"),
+					Name: lo.ToPtr("Secondary Layer One"),
+				},
+			},
+			Users: new([]client.UserV1),
+		},
+	}) + "\n"
+	config += "}\n"
+
+	return config
+}
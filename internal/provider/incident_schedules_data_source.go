@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentSchedulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentSchedulesDataSource{}
+)
+
+func NewIncidentSchedulesDataSource() datasource.DataSource {
+	return &IncidentSchedulesDataSource{}
+}
+
+type IncidentSchedulesDataSource struct {
+	client    *client.ClientWithResponses
+	dashboard *DashboardURL
+}
+
+type IncidentSchedulesDataSourceModel struct {
+	NamePrefix types.String                               `tfsdk:"name_prefix"`
+	Limit      types.Int64                                `tfsdk:"limit"`
+	After      types.String                               `tfsdk:"after"`
+	TotalCount types.Int64                                `tfsdk:"total_count"`
+	Schedules  []IncidentSchedulesDataSourceScheduleModel `tfsdk:"schedules"`
+}
+
+type IncidentSchedulesDataSourceScheduleModel struct {
+	ID            types.String                 `tfsdk:"id"`
+	Name          types.String                 `tfsdk:"name"`
+	Timezone      types.String                 `tfsdk:"timezone"`
+	AppURL        types.String                 `tfsdk:"app_url"`
+	CurrentShifts []IncidentScheduleShiftModel `tfsdk:"current_shifts"`
+}
+
+func (i *IncidentSchedulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedules"
+}
+
+func (i *IncidentSchedulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists the on-call schedules in your organisation, optionally only those whose name starts with a prefix. Use it to audit which schedules exist, or with `for_each` to create resources for each schedule, such as an escalation path per team. The API doesn't record which team owns a schedule, so to filter by team, name schedules after the team and use `name_prefix`.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return schedules whose name starts with this prefix. Teams that name their schedules consistently, such as `Payments: Primary`, can use this to find all of a team's schedules.",
+				Optional:            true,
+			},
+			"schedules": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching schedules, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "name"),
+							Computed:            true,
+						},
+						"timezone": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("ScheduleV2ResponseBody", "timezone"),
+							Computed:            true,
+						},
+						"app_url": schema.StringAttribute{
+							MarkdownDescription: "Link to this schedule in the incident.io dashboard.",
+							Computed:            true,
+						},
+						"current_shifts": schema.ListNestedAttribute{
+							MarkdownDescription: "The shifts happening now, ordered by when they end.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: scheduleShiftAttributes(),
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentSchedulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilyOnCall)
+	i.dashboard = client.DashboardURL
+}
+
+func (i *IncidentSchedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentSchedulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedules, err := listSchedules(ctx, i.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list schedules, got error: %s", err))
+		return
+	}
+
+	schedules = lo.Filter(schedules, func(schedule client.ScheduleV2, _ int) bool {
+		return strings.HasPrefix(schedule.Name, data.NamePrefix.ValueString())
+	})
+	sort.SliceStable(schedules, func(a, b int) bool {
+		return schedules[a].Name < schedules[b].Name
+	})
+	data.TotalCount = types.Int64Value(int64(len(schedules)))
+
+	schedules = paginateSlice(schedules, func(schedule client.ScheduleV2) string {
+		return schedule.Id
+	}, data.Limit, data.After)
+
+	data.Schedules = lo.Map(schedules, func(schedule client.ScheduleV2, _ int) IncidentSchedulesDataSourceScheduleModel {
+		return IncidentSchedulesDataSourceScheduleModel{
+			ID:            types.StringValue(schedule.Id),
+			Name:          types.StringValue(schedule.Name),
+			Timezone:      types.StringValue(schedule.Timezone),
+			AppURL:        i.dashboard.Build(ctx, "on-call", "schedules", schedule.Id),
+			CurrentShifts: buildScheduleShifts(lo.FromPtr(schedule.CurrentShifts)),
+		}
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
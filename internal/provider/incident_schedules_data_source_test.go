@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentSchedulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentScheduleResourceConfig(&client.ScheduleV2{
+					Name: "Terraform schedules data source",
+				}) + `
+data "incident_schedules" "matching" {
+  name_prefix = "Terraform schedules"
+
+  depends_on = [incident_schedule.example]
+}
+
+data "incident_schedules" "limited" {
+  name_prefix = "Terraform schedules"
+  limit       = 0
+
+  depends_on = [incident_schedule.example]
+}
+
+data "incident_schedules" "not_matching" {
+  name_prefix = "Terraform schedules data source that doesn't exist"
+
+  depends_on = [incident_schedule.example]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.incident_schedules.matching", "schedules.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_schedules.matching", "schedules.0.id",
+						"incident_schedule.example", "id"),
+					resource.TestCheckResourceAttr(
+						"data.incident_schedules.matching", "total_count", "1"),
+					resource.TestCheckResourceAttr(
+						"data.incident_schedules.limited", "schedules.#", "0"),
+					resource.TestCheckResourceAttr(
+						"data.incident_schedules.limited", "total_count", "1"),
+					resource.TestCheckResourceAttr(
+						"data.incident_schedules.not_matching", "schedules.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentSettingsLookupDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentSettingsLookupDataSource{}
+)
+
+func NewIncidentSettingsLookupDataSource() datasource.DataSource {
+	return &IncidentSettingsLookupDataSource{}
+}
+
+type IncidentSettingsLookupDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentSettingsLookupDataSourceModel struct {
+	Severities              types.Set `tfsdk:"severities"`
+	IncidentRoles           types.Set `tfsdk:"incident_roles"`
+	IncidentStatuses        types.Set `tfsdk:"incident_statuses"`
+	SeverityIDs             types.Map `tfsdk:"severity_ids"`
+	IncidentRoleIDs         types.Map `tfsdk:"incident_role_ids"`
+	IncidentStatusIDs       types.Map `tfsdk:"incident_status_ids"`
+	MissingSeverities       types.Set `tfsdk:"missing_severities"`
+	MissingIncidentRoles    types.Set `tfsdk:"missing_incident_roles"`
+	MissingIncidentStatuses types.Set `tfsdk:"missing_incident_statuses"`
+}
+
+func (i *IncidentSettingsLookupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_settings_lookup"
+}
+
+func (i *IncidentSettingsLookupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	expected := func(kind string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("The names of the %s you expect to exist. If not set, every one is looked up.", kind),
+			ElementType:         types.StringType,
+			Optional:            true,
+		}
+	}
+	ids := func(kind string) schema.MapAttribute {
+		return schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("The IDs of the %s that exist, keyed by name.", kind),
+			ElementType:         types.StringType,
+			Computed:            true,
+		}
+	}
+	missing := func(kind string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("The names of the expected %s that don't exist.", kind),
+			ElementType:         types.StringType,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `
+This data source looks up severities, incident roles and incident statuses by name,
+for modules that need to work with an organisation's existing settings (such as the
+defaults every organisation starts with) without importing them.
+
+Data sources can't create anything, so use the ` + "`missing_*`" + ` attributes to fail
+early with a clear error, or to decide which settings your module should manage.
+		`,
+		Attributes: map[string]schema.Attribute{
+			"severities":                expected("severities"),
+			"incident_roles":            expected("incident roles"),
+			"incident_statuses":         expected("incident statuses"),
+			"severity_ids":              ids("severities"),
+			"incident_role_ids":         ids("incident roles"),
+			"incident_status_ids":       ids("incident statuses"),
+			"missing_severities":        missing("severities"),
+			"missing_incident_roles":    missing("incident roles"),
+			"missing_incident_statuses": missing("incident statuses"),
+		},
+	}
+}
+
+func (i *IncidentSettingsLookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentSettingsLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentSettingsLookupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	severities, err := i.client.SeveritiesV1ListWithResponse(ctx)
+	if err == nil && severities.StatusCode() >= 400 {
+		err = clientError(severities.StatusCode(), severities.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list severities, got error: %s", err))
+		return
+	}
+
+	roles, err := i.client.IncidentRolesV2ListWithResponse(ctx)
+	if err == nil && roles.StatusCode() >= 400 {
+		err = clientError(roles.StatusCode(), roles.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident roles, got error: %s", err))
+		return
+	}
+
+	statuses, err := i.client.IncidentStatusesV1ListWithResponse(ctx)
+	if err == nil && statuses.StatusCode() >= 400 {
+		err = clientError(statuses.StatusCode(), statuses.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident statuses, got error: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.SeverityIDs, data.MissingSeverities, diags = lookupByName(ctx, data.Severities,
+		lo.SliceToMap(severities.JSON200.Severities, func(severity client.SeverityV2) (string, string) {
+			return severity.Name, severity.Id
+		}))
+	resp.Diagnostics.Append(diags...)
+
+	data.IncidentRoleIDs, data.MissingIncidentRoles, diags = lookupByName(ctx, data.IncidentRoles,
+		lo.SliceToMap(roles.JSON200.IncidentRoles, func(role client.IncidentRoleV2) (string, string) {
+			return role.Name, role.Id
+		}))
+	resp.Diagnostics.Append(diags...)
+
+	data.IncidentStatusIDs, data.MissingIncidentStatuses, diags = lookupByName(ctx, data.IncidentStatuses,
+		lo.SliceToMap(statuses.JSON200.IncidentStatuses, func(status client.IncidentStatusV1) (string, string) {
+			return status.Name, status.Id
+		}))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// lookupByName finds the IDs of the expected names, returning those that were found
+// and the names of any that weren't. If no names are expected, every ID is returned.
+func lookupByName(ctx context.Context, expected types.Set, idsByName map[string]string) (types.Map, types.Set, diag.Diagnostics) {
+	names, diags := stringElements(ctx, expected)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), types.SetNull(types.StringType), diags
+	}
+	if expected.IsNull() {
+		names = lo.Keys(idsByName)
+	}
+	sort.Strings(names)
+
+	ids := map[string]attr.Value{}
+	missing := []string{}
+	for _, name := range names {
+		if id, ok := idsByName[name]; ok {
+			ids[name] = types.StringValue(id)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	return types.MapValueMust(types.StringType, ids), stringSetValue(missing), diags
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestLookupByName(t *testing.T) {
+	idsByName := map[string]string{
+		"Minor": "01FCNDV6P870EA6S7TK1DSYDG0",
+		"Major": "01FCNDV6P870EA6S7TK1DSYDG1",
+	}
+
+	for _, tc := range []struct {
+		name            string
+		expected        types.Set
+		expectedIDs     map[string]string
+		expectedMissing []string
+	}{
+		{
+			name:            "finds expected names and reports missing ones",
+			expected:        stringSetValue([]string{"Minor", "Critical"}),
+			expectedIDs:     map[string]string{"Minor": "01FCNDV6P870EA6S7TK1DSYDG0"},
+			expectedMissing: []string{"Critical"},
+		},
+		{
+			name:            "looks up everything when no names are expected",
+			expected:        types.SetNull(types.StringType),
+			expectedIDs:     idsByName,
+			expectedMissing: []string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ids, missing, diags := lookupByName(context.Background(), tc.expected, idsByName)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			expectedIDs := map[string]attr.Value{}
+			for name, id := range tc.expectedIDs {
+				expectedIDs[name] = types.StringValue(id)
+			}
+			if want := types.MapValueMust(types.StringType, expectedIDs); !ids.Equal(want) {
+				t.Errorf("expected ids %v, got %v", want, ids)
+			}
+			if want := stringSetValue(tc.expectedMissing); !missing.Equal(want) {
+				t.Errorf("expected missing %v, got %v", want, missing)
+			}
+		})
+	}
+}
+
+func TestAccIncidentSettingsLookupDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "incident_severity" "example" {
+  name        = "Lookup"
+  description = "Used to test looking up settings by name."
+}
+
+data "incident_settings_lookup" "settings" {
+  severities = [incident_severity.example.name, "Does not exist"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.incident_settings_lookup.settings", "severity_ids.Lookup",
+						"incident_severity.example", "id"),
+					resource.TestCheckTypeSetElemAttr(
+						"data.incident_settings_lookup.settings", "missing_severities.*", "Does not exist"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentSeveritiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentSeveritiesDataSource{}
+)
+
+func NewIncidentSeveritiesDataSource() datasource.DataSource {
+	return &IncidentSeveritiesDataSource{}
+}
+
+type IncidentSeveritiesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentSeveritiesDataSourceModel struct {
+	Limit      types.Int64                     `tfsdk:"limit"`
+	After      types.String                    `tfsdk:"after"`
+	TotalCount types.Int64                     `tfsdk:"total_count"`
+	Severities []IncidentSeverityResourceModel `tfsdk:"severities"`
+}
+
+func (i *IncidentSeveritiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_severities"
+}
+
+func (i *IncidentSeveritiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists every severity in your organisation, ordered by rank. Use it to check your severity scheme is what a module expects, or to select severities by rank, such as every severity at or above a given one.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"severities": schema.ListNestedAttribute{
+				MarkdownDescription: "The severities, ordered by rank from least to most severe.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("SeverityV1ResponseBody", "id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("SeveritiesV1CreateRequestBody", "name"),
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("SeveritiesV1CreateRequestBody", "description"),
+							Computed:            true,
+						},
+						"rank": schema.Int64Attribute{
+							MarkdownDescription: apischema.Docstring("SeveritiesV1CreateRequestBody", "rank"),
+							Computed:            true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (i *IncidentSeveritiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentSeveritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentSeveritiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.SeveritiesV1ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list severities, got error: %s", err))
+		return
+	}
+
+	data.Severities = buildSeveritiesByRank(result.JSON200.Severities)
+	data.TotalCount = types.Int64Value(int64(len(data.Severities)))
+
+	data.Severities = paginateSlice(data.Severities, func(severity IncidentSeverityResourceModel) string {
+		return severity.ID.ValueString()
+	}, data.Limit, data.After)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildSeveritiesByRank returns models for each severity, ordered by rank. Severities
+// with the same rank keep the order the API returned them in.
+func buildSeveritiesByRank(severities []client.SeverityV2) []IncidentSeverityResourceModel {
+	severities = append([]client.SeverityV2{}, severities...)
+	sort.SliceStable(severities, func(a, b int) bool {
+		return severities[a].Rank < severities[b].Rank
+	})
+
+	return lo.Map(severities, func(severity client.SeverityV2, _ int) IncidentSeverityResourceModel {
+		return *new(IncidentSeverityResource).buildModel(severity, &IncidentSeverityResourceModel{})
+	})
+}
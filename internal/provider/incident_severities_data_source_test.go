@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestBuildSeveritiesByRank(t *testing.T) {
+	severities := buildSeveritiesByRank([]client.SeverityV2{
+		{Id: "critical", Name: "Critical", Rank: 3},
+		{Id: "minor", Name: "Minor", Rank: 1},
+		{Id: "major", Name: "Major", Rank: 2},
+		{Id: "also-minor", Name: "Also minor", Rank: 1},
+	})
+
+	ids := lo.Map(severities, func(severity IncidentSeverityResourceModel, _ int) string {
+		return severity.ID.ValueString()
+	})
+	if expected := []string{"minor", "also-minor", "major", "critical"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestAccIncidentSeveritiesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentSeverityResourceConfig(nil) + `
+data "incident_severities" "all" {
+  depends_on = [incident_severity.example]
+}
+
+data "incident_severities" "after_first" {
+  after = data.incident_severities.all.severities[0].id
+}
+
+locals {
+  example = one([for severity in data.incident_severities.all.severities : severity if severity.id == incident_severity.example.id])
+}
+
+output "example_rank" {
+  value = local.example.rank
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.incident_severities.all", "severities.0.id"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_severities.after_first", "severities.0.id",
+						"data.incident_severities.all", "severities.1.id"),
+					resource.TestCheckOutput("example_rank", "7"),
+				),
+			},
+		},
+	})
+}
@@ -83,7 +83,7 @@ func (r *IncidentSeverityResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilySettings)
 }
 
 func (r *IncidentSeverityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -102,7 +102,7 @@ func (r *IncidentSeverityResource) Create(ctx context.Context, req resource.Crea
 		Rank:        rank,
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident severity, got error: %s", err))
@@ -110,7 +110,7 @@ func (r *IncidentSeverityResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident severity resource with id=%s", result.JSON201.Severity.Id))
-	data = r.buildModel(result.JSON201.Severity)
+	data = r.buildModel(result.JSON201.Severity, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -133,7 +133,12 @@ func (r *IncidentSeverityResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	data = r.buildModel(result.JSON200.Severity)
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident severity, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.Severity, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -154,14 +159,14 @@ func (r *IncidentSeverityResource) Update(ctx context.Context, req resource.Upda
 		Rank:        rank,
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident severity, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.Severity)
+	data = r.buildModel(result.JSON200.Severity, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -172,7 +177,10 @@ func (r *IncidentSeverityResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, err := r.client.SeveritiesV1DeleteWithResponse(ctx, data.ID.ValueString())
+	result, err := r.client.SeveritiesV1DeleteWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete incident severity, got error: %s", err))
 		return
@@ -183,11 +191,13 @@ func (r *IncidentSeverityResource) ImportState(ctx context.Context, req resource
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *IncidentSeverityResource) buildModel(severity client.SeverityV2) *IncidentSeverityResourceModel {
+// buildModel generates a terraform model from the API response, keeping the
+// previous description if it only differs from the API in formatting.
+func (r *IncidentSeverityResource) buildModel(severity client.SeverityV2, previous *IncidentSeverityResourceModel) *IncidentSeverityResourceModel {
 	return &IncidentSeverityResourceModel{
 		ID:          types.StringValue(severity.Id),
 		Name:        types.StringValue(severity.Name),
-		Description: types.StringValue(severity.Description),
+		Description: preserveRichText(previous.Description, severity.Description),
 		Rank:        types.Int64Value(severity.Rank),
 	}
 }
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentStatusDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentStatusDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentStatusDataSource{}
+)
+
+func NewIncidentStatusDataSource() datasource.DataSource {
+	return &IncidentStatusDataSource{}
+}
+
+type IncidentStatusDataSource struct {
+	client *client.ClientWithResponses
+}
+
+func (i *IncidentStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status"
+}
+
+func (i *IncidentStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source looks up an incident status by name, category or both, so policies and workflows can refer to a status without hardcoding its ID. It fails if no status, or more than one, matches.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentStatusV1ResponseBody", "id"),
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentStatusesV1CreateRequestBody", "name"),
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentStatusesV1CreateRequestBody", "description"),
+				Computed:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("IncidentStatusesV1CreateRequestBody", "category"),
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (i *IncidentStatusDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IncidentStatusResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.IsNull() && data.Category.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing incident status lookup",
+			"At least one of name or category must be set.",
+		)
+	}
+}
+
+func (i *IncidentStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentStatusResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.IncidentStatusesV1ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident statuses, got error: %s", err))
+		return
+	}
+
+	status, err := findIncidentStatus(result.JSON200.IncidentStatuses, data.Name, data.Category)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find incident status, got error: %s", err))
+		return
+	}
+
+	modelResp := new(IncidentStatusResource).buildModel(*status, &IncidentStatusResourceModel{})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
+
+// findIncidentStatus finds the single status with the given name and category,
+// ignoring whichever of them is null.
+func findIncidentStatus(statuses []client.IncidentStatusV1, name, category types.String) (*client.IncidentStatusV1, error) {
+	descriptions := []string{}
+	if !name.IsNull() {
+		descriptions = append(descriptions, fmt.Sprintf("name %q", name.ValueString()))
+	}
+	if !category.IsNull() {
+		descriptions = append(descriptions, fmt.Sprintf("category %q", category.ValueString()))
+	}
+	description := strings.Join(descriptions, " and ")
+
+	matches := lo.Filter(statuses, func(status client.IncidentStatusV1, _ int) bool {
+		return (name.IsNull() || status.Name == name.ValueString()) &&
+			(category.IsNull() || string(status.Category) == category.ValueString())
+	})
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no incident status with %s", description)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d incident statuses with %s, set name to pick one", len(matches), description)
+	}
+}
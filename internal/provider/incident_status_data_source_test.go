@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestFindIncidentStatus(t *testing.T) {
+	statuses := []client.IncidentStatusV1{
+		{Id: "01GW2G3V0S59R238FAHPDS1R66", Name: "Investigating", Category: client.IncidentStatusV1CategoryLive},
+		{Id: "01GW2G3V0S59R238FAHPDS1R67", Name: "Fixing", Category: client.IncidentStatusV1CategoryLive},
+		{Id: "01GW2G3V0S59R238FAHPDS1R68", Name: "Triage", Category: client.IncidentStatusV1CategoryTriage},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		statusName  types.String
+		category    types.String
+		expectedID  string
+		expectedErr string
+	}{
+		{
+			name:       "by name",
+			statusName: types.StringValue("Fixing"),
+			category:   types.StringNull(),
+			expectedID: "01GW2G3V0S59R238FAHPDS1R67",
+		},
+		{
+			name:       "by category",
+			statusName: types.StringNull(),
+			category:   types.StringValue("triage"),
+			expectedID: "01GW2G3V0S59R238FAHPDS1R68",
+		},
+		{
+			name:       "by name and category",
+			statusName: types.StringValue("Investigating"),
+			category:   types.StringValue("live"),
+			expectedID: "01GW2G3V0S59R238FAHPDS1R66",
+		},
+		{
+			name:        "by name in the wrong category",
+			statusName:  types.StringValue("Investigating"),
+			category:    types.StringValue("learning"),
+			expectedErr: `no incident status with name "Investigating" and category "learning"`,
+		},
+		{
+			name:        "by ambiguous category",
+			statusName:  types.StringNull(),
+			category:    types.StringValue("live"),
+			expectedErr: `found 2 incident statuses with category "live", set name to pick one`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := findIncidentStatus(statuses, tc.statusName, tc.category)
+			if tc.expectedErr != "" {
+				if err == nil || err.Error() != tc.expectedErr {
+					t.Fatalf("expected error %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if status.Id != tc.expectedID {
+				t.Errorf("expected status %s, got %s", tc.expectedID, status.Id)
+			}
+		})
+	}
+}
+
+func TestAccIncidentStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentStatusResourceConfig(nil) + `
+data "incident_status" "example" {
+  name     = incident_status.example.name
+  category = incident_status.example.category
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.incident_status.example", "id",
+						"incident_status.example", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_status.example", "description",
+						"incident_status.example", "description"),
+				),
+			},
+			{
+				Config: `
+data "incident_status" "missing" {}
+`,
+				ExpectError: regexp.MustCompile("At least one of name or category must be set"),
+			},
+		},
+	})
+}
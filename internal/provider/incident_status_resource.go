@@ -84,7 +84,7 @@ func (r *IncidentStatusResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilySettings)
 }
 
 func (r *IncidentStatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -100,7 +100,7 @@ func (r *IncidentStatusResource) Create(ctx context.Context, req resource.Create
 		Category:    client.CreateRequestBody8Category(data.Category.ValueString()),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident status, got error: %s", err))
@@ -108,7 +108,7 @@ func (r *IncidentStatusResource) Create(ctx context.Context, req resource.Create
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident status resource with id=%s", result.JSON201.IncidentStatus.Id))
-	data = r.buildModel(result.JSON201.IncidentStatus)
+	data = r.buildModel(result.JSON201.IncidentStatus, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -130,7 +130,12 @@ func (r *IncidentStatusResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentStatus)
+	if result.StatusCode() >= 400 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident status, got error: %s", clientError(result.StatusCode(), result.Body)))
+		return
+	}
+
+	data = r.buildModel(result.JSON200.IncidentStatus, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -146,14 +151,14 @@ func (r *IncidentStatusResource) Update(ctx context.Context, req resource.Update
 		Description: data.Description.ValueString(),
 	})
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident status, got error: %s", err))
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentStatus)
+	data = r.buildModel(result.JSON200.IncidentStatus, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -164,7 +169,10 @@ func (r *IncidentStatusResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	_, err := r.client.IncidentStatusesV1DeleteWithResponse(ctx, data.ID.ValueString())
+	result, err := r.client.IncidentStatusesV1DeleteWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete incident status, got error: %s", err))
 		return
@@ -175,11 +183,13 @@ func (r *IncidentStatusResource) ImportState(ctx context.Context, req resource.I
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func (r *IncidentStatusResource) buildModel(status client.IncidentStatusV1) *IncidentStatusResourceModel {
+// buildModel generates a terraform model from the API response, keeping the
+// previous description if it only differs from the API in formatting.
+func (r *IncidentStatusResource) buildModel(status client.IncidentStatusV1, previous *IncidentStatusResourceModel) *IncidentStatusResourceModel {
 	return &IncidentStatusResourceModel{
 		ID:          types.StringValue(status.Id),
 		Name:        types.StringValue(status.Name),
-		Description: types.StringValue(status.Description),
+		Description: preserveRichText(previous.Description, status.Description),
 		Category:    types.StringValue(string(status.Category)),
 	}
 }
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentStatusesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentStatusesDataSource{}
+)
+
+// incidentStatusCategories are the categories of incident status in the order an
+// incident moves through them, followed by those it can leave the lifecycle through.
+var incidentStatusCategories = []client.IncidentStatusV1Category{
+	client.IncidentStatusV1CategoryTriage,
+	client.IncidentStatusV1CategoryLive,
+	client.IncidentStatusV1CategoryLearning,
+	client.IncidentStatusV1CategoryClosed,
+	client.IncidentStatusV1CategoryPaused,
+	client.IncidentStatusV1CategoryDeclined,
+	client.IncidentStatusV1CategoryMerged,
+	client.IncidentStatusV1CategoryCanceled,
+}
+
+func NewIncidentStatusesDataSource() datasource.DataSource {
+	return &IncidentStatusesDataSource{}
+}
+
+type IncidentStatusesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentStatusesDataSourceModel struct {
+	Limit         types.Int64                       `tfsdk:"limit"`
+	After         types.String                      `tfsdk:"after"`
+	TotalCount    types.Int64                       `tfsdk:"total_count"`
+	Statuses      []IncidentStatusesDataSourceEntry `tfsdk:"statuses"`
+	IDsByCategory types.Map                         `tfsdk:"ids_by_category"`
+}
+
+type IncidentStatusesDataSourceEntry struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Category    types.String `tfsdk:"category"`
+	Rank        types.Int64  `tfsdk:"rank"`
+}
+
+func (i *IncidentStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statuses"
+}
+
+func (i *IncidentStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source lists every incident status in your organisation, ordered by where they come in an incident's lifecycle. Use it to build policies that depend on the stage an incident is at, or to check the order of your statuses.",
+		Attributes: paginationAttributes(map[string]schema.Attribute{
+			"statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "The incident statuses, ordered by category (`triage`, `live`, `learning`, `closed`, then `paused`, `declined`, `merged` and `canceled`) and then by rank within each category.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentStatusV1ResponseBody", "id"),
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentStatusesV1CreateRequestBody", "name"),
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentStatusesV1CreateRequestBody", "description"),
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IncidentStatusV1ResponseBody", "category"),
+							Computed:            true,
+						},
+						"rank": schema.Int64Attribute{
+							MarkdownDescription: apischema.Docstring("IncidentStatusV1ResponseBody", "rank"),
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ids_by_category": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the statuses in `statuses` for each category, ordered by rank. Every category is present, even if it has no statuses.",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Computed:            true,
+			},
+		}),
+	}
+}
+
+func (i *IncidentStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	i.client = client.ClientFor(apiKeyFamilySettings)
+}
+
+func (i *IncidentStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentStatusesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := i.client.IncidentStatusesV1ListWithResponse(ctx)
+	if err == nil && result.StatusCode() >= 400 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident statuses, got error: %s", err))
+		return
+	}
+
+	statuses := sortIncidentStatuses(result.JSON200.IncidentStatuses)
+	data.TotalCount = types.Int64Value(int64(len(statuses)))
+
+	statuses = paginateSlice(statuses, func(status client.IncidentStatusV1) string {
+		return status.Id
+	}, data.Limit, data.After)
+
+	data.Statuses = lo.Map(statuses, func(status client.IncidentStatusV1, _ int) IncidentStatusesDataSourceEntry {
+		model := new(IncidentStatusResource).buildModel(status, &IncidentStatusResourceModel{})
+
+		return IncidentStatusesDataSourceEntry{
+			ID:          model.ID,
+			Name:        model.Name,
+			Description: model.Description,
+			Category:    model.Category,
+			Rank:        types.Int64Value(status.Rank),
+		}
+	})
+	data.IDsByCategory = buildIncidentStatusIDsByCategory(statuses)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sortIncidentStatuses orders statuses by the position of their category in
+// incidentStatusCategories, then by rank. Categories we don't know about come last.
+func sortIncidentStatuses(statuses []client.IncidentStatusV1) []client.IncidentStatusV1 {
+	position := func(status client.IncidentStatusV1) int {
+		if idx := lo.IndexOf(incidentStatusCategories, status.Category); idx >= 0 {
+			return idx
+		}
+
+		return len(incidentStatusCategories)
+	}
+
+	statuses = append([]client.IncidentStatusV1{}, statuses...)
+	sort.SliceStable(statuses, func(a, b int) bool {
+		if position(statuses[a]) != position(statuses[b]) {
+			return position(statuses[a]) < position(statuses[b])
+		}
+
+		return statuses[a].Rank < statuses[b].Rank
+	})
+
+	return statuses
+}
+
+// buildIncidentStatusIDsByCategory groups the IDs of already sorted statuses by their
+// category, including an empty list for each known category without any statuses.
+func buildIncidentStatusIDsByCategory(statuses []client.IncidentStatusV1) types.Map {
+	ids := map[string][]string{}
+	for _, category := range incidentStatusCategories {
+		ids[string(category)] = []string{}
+	}
+	for _, status := range statuses {
+		ids[string(status.Category)] = append(ids[string(status.Category)], status.Id)
+	}
+
+	return types.MapValueMust(types.ListType{ElemType: types.StringType}, lo.MapValues(ids, func(ids []string, _ string) attr.Value {
+		return stringListValue(ids)
+	}))
+}
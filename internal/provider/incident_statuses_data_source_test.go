@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/samber/lo"
+)
+
+func TestSortIncidentStatuses(t *testing.T) {
+	statuses := sortIncidentStatuses([]client.IncidentStatusV1{
+		{Id: "closed", Category: client.IncidentStatusV1CategoryClosed, Rank: 1},
+		{Id: "monitoring", Category: client.IncidentStatusV1CategoryLive, Rank: 2},
+		{Id: "triage", Category: client.IncidentStatusV1CategoryTriage, Rank: 1},
+		{Id: "unknown", Category: client.IncidentStatusV1Category("snoozed"), Rank: 1},
+		{Id: "investigating", Category: client.IncidentStatusV1CategoryLive, Rank: 1},
+		{Id: "declined", Category: client.IncidentStatusV1CategoryDeclined, Rank: 1},
+	})
+
+	ids := lo.Map(statuses, func(status client.IncidentStatusV1, _ int) string {
+		return status.Id
+	})
+	if expected := []string{"triage", "investigating", "monitoring", "closed", "declined", "unknown"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+}
+
+func TestBuildIncidentStatusIDsByCategory(t *testing.T) {
+	idsByCategory := buildIncidentStatusIDsByCategory([]client.IncidentStatusV1{
+		{Id: "investigating", Category: client.IncidentStatusV1CategoryLive},
+		{Id: "monitoring", Category: client.IncidentStatusV1CategoryLive},
+	})
+
+	var ids map[string][]string
+	if diags := idsByCategory.ElementsAs(context.Background(), &ids, false); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if expected := []string{"investigating", "monitoring"}; !reflect.DeepEqual(ids["live"], expected) {
+		t.Errorf("expected live statuses %v, got %v", expected, ids["live"])
+	}
+	if len(ids) != len(incidentStatusCategories) || len(ids["triage"]) != 0 {
+		t.Errorf("expected every category to be present, got %v", ids)
+	}
+}
+
+func TestAccIncidentStatusesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentStatusResourceConfig(nil) + `
+data "incident_statuses" "all" {
+  depends_on = [incident_status.example]
+}
+
+data "incident_statuses" "first" {
+  limit = 1
+}
+
+output "example_in_category" {
+  value = contains(data.incident_statuses.all.ids_by_category[incident_status.example.category], incident_status.example.id)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.incident_statuses.all", "statuses.0.id"),
+					resource.TestCheckOutput("example_in_category", "true"),
+					resource.TestCheckResourceAttr(
+						"data.incident_statuses.first", "statuses.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_statuses.first", "statuses.0.id",
+						"data.incident_statuses.all", "statuses.0.id"),
+				),
+			},
+		},
+	})
+}
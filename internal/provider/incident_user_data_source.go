@@ -50,7 +50,7 @@ func (i *IncidentUserDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	i.client = client.Client
+	i.client = client.ClientFor(apiKeyFamilySettings)
 }
 
 func (i *IncidentUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,7 +68,7 @@ func (i *IncidentUserDataSource) Read(ctx context.Context, req datasource.ReadRe
 		}
 		result, err := i.client.UsersV2ShowWithResponse(ctx, data.ID.ValueString())
 		if err == nil && result.StatusCode() >= 400 {
-			err = fmt.Errorf(string(result.Body))
+			err = clientError(result.StatusCode(), result.Body)
 		}
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
@@ -80,7 +80,7 @@ func (i *IncidentUserDataSource) Read(ctx context.Context, req datasource.ReadRe
 			Email: data.Email.ValueStringPointer(),
 		})
 		if err == nil && result.StatusCode() >= 400 {
-			err = fmt.Errorf(string(result.Body))
+			err = clientError(result.StatusCode(), result.Body)
 		}
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
@@ -99,7 +99,7 @@ func (i *IncidentUserDataSource) Read(ctx context.Context, req datasource.ReadRe
 			SlackUserId: data.SlackUserID.ValueStringPointer(),
 		})
 		if err == nil && result.StatusCode() >= 400 {
-			err = fmt.Errorf(string(result.Body))
+			err = clientError(result.StatusCode(), result.Body)
 		}
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
@@ -25,6 +25,7 @@ var (
 type IncidentWorkflowResource struct {
 	client           *client.ClientWithResponses
 	terraformVersion string
+	dashboard        *DashboardURL
 }
 
 func NewIncidentWorkflowResource() resource.Resource {
@@ -46,6 +47,7 @@ type IncidentWorkflowResourceModel struct {
 	RunsOnIncidents         types.String                  `tfsdk:"runs_on_incidents"`
 	RunsOnIncidentModes     []types.String                `tfsdk:"runs_on_incident_modes"`
 	State                   types.String                  `tfsdk:"state"`
+	AppURL                  types.String                  `tfsdk:"app_url"`
 }
 
 type IncidentWorkflowStep struct {
@@ -70,6 +72,13 @@ func (r *IncidentWorkflowResource) Schema(ctx context.Context, req resource.Sche
 
 We'd generally recommend building workflows in our [web dashboard](https://app.incident.io/workflows), and using the 'Export' flow to generate your Terraform, as it's easier to see what you've configured. You can also make changes to an existing workflow and copy the resulting Terraform without persisting it. You can learn more in this [Loom](https://www.loom.com/share/b833d7d0fd114d6ba3f24d8c72e5208f?sid=c6d3cc3f-aa93-44ba-b12d-a0a4cbe09448).`,
 		Attributes: map[string]schema.Attribute{
+			"app_url": schema.StringAttribute{
+				MarkdownDescription: "Link to this workflow in the incident.io dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: apischema.Docstring("WorkflowResponseBody", "id"),
 				Computed:            true,
@@ -187,7 +196,7 @@ func (r *IncidentWorkflowResource) Create(ctx context.Context, req resource.Crea
 		ContinueOnStepError:     data.ContinueOnStepError.ValueBool(),
 		State:                   lo.ToPtr(client.CreateWorkflowRequestBodyState(data.State.ValueString())),
 		Annotations: &map[string]string{
-			"incident.io/terraform/version": r.terraformVersion,
+			terraformVersionAnnotation: r.terraformVersion,
 		},
 	}
 
@@ -200,7 +209,7 @@ func (r *IncidentWorkflowResource) Create(ctx context.Context, req resource.Crea
 
 	result, err := r.client.WorkflowsV2CreateWorkflowWithResponse(ctx, payload)
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s", err))
@@ -209,6 +218,7 @@ func (r *IncidentWorkflowResource) Create(ctx context.Context, req resource.Crea
 
 	tflog.Trace(ctx, fmt.Sprintf("created a workflow resource with id=%s", result.JSON201.Workflow.Id))
 	data = r.buildModel(result.JSON201.Workflow)
+	data.AppURL = r.dashboard.Build(ctx, "workflows", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -248,7 +258,7 @@ func (r *IncidentWorkflowResource) Update(ctx context.Context, req resource.Upda
 		ContinueOnStepError:     data.ContinueOnStepError.ValueBool(),
 		State:                   lo.ToPtr(client.UpdateWorkflowRequestBodyState(data.State.ValueString())),
 		Annotations: &map[string]string{
-			"incident.io/terraform/version": r.terraformVersion,
+			terraformVersionAnnotation: r.terraformVersion,
 		},
 	}
 
@@ -261,7 +271,7 @@ func (r *IncidentWorkflowResource) Update(ctx context.Context, req resource.Upda
 
 	result, err := r.client.WorkflowsV2UpdateWorkflowWithResponse(ctx, state.ID.ValueString(), payload)
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow, got error: %s", err))
@@ -269,6 +279,7 @@ func (r *IncidentWorkflowResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	data = r.buildModel(result.JSON200.Workflow)
+	data.AppURL = r.dashboard.Build(ctx, "workflows", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -281,7 +292,7 @@ func (r *IncidentWorkflowResource) Read(ctx context.Context, req resource.ReadRe
 
 	result, err := r.client.WorkflowsV2ShowWorkflowWithResponse(ctx, data.ID.ValueString())
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
@@ -289,6 +300,7 @@ func (r *IncidentWorkflowResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	data = r.buildModel(result.JSON200.Workflow)
+	data.AppURL = r.dashboard.Build(ctx, "workflows", data.ID.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -299,7 +311,10 @@ func (r *IncidentWorkflowResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, err := r.client.WorkflowsV2DestroyWorkflowWithResponse(ctx, data.ID.ValueString())
+	result, err := r.client.WorkflowsV2DestroyWorkflowWithResponse(ctx, data.ID.ValueString())
+	if err == nil && result.StatusCode() >= 400 && result.StatusCode() != 404 {
+		err = clientError(result.StatusCode(), result.Body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow, got error: %s", err))
 		return
@@ -326,7 +341,8 @@ func (r *IncidentWorkflowResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	r.client = client.Client
+	r.client = client.ClientFor(apiKeyFamilyWorkflows)
+	r.dashboard = client.DashboardURL
 	r.terraformVersion = client.TerraformVersion
 }
 
@@ -0,0 +1,28 @@
+package provider
+
+import "sync"
+
+// Some resources manage part of an object that the API only lets us update as a
+// whole, such as one attribute value of a catalog entry, so they have to read the
+// object, change their part, and write it back. These locks stop resources for the
+// same object from overwriting each other's changes when Terraform applies them in
+// parallel.
+var (
+	objectLocks = map[string]*sync.Mutex{}
+	objectMutex sync.Mutex
+)
+
+// lockObject locks the object with the given ID, returning a function to unlock it.
+func lockObject(id string) func() {
+	objectMutex.Lock()
+	mutex, ok := objectLocks[id]
+	if !ok {
+		mutex = new(sync.Mutex)
+		objectLocks[id] = mutex
+	}
+	objectMutex.Unlock()
+
+	mutex.Lock()
+
+	return mutex.Unlock
+}
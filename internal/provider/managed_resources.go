@@ -6,6 +6,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
 )
 
 func claimResource(
@@ -18,7 +20,7 @@ func claimResource(
 ) {
 	payload := client.CreateManagedResourceRequestBody{
 		Annotations: map[string]string{
-			"incident.io/terraform/version": terraformVersion,
+			terraformVersionAnnotation: terraformVersion,
 		},
 		ResourceType: client.CreateManagedResourceRequestBodyResourceType(resourceType),
 		ResourceId:   req.ID,
@@ -26,10 +28,78 @@ func claimResource(
 
 	result, err := apiClient.ManagedResourcesV2CreateManagedResourceWithResponse(ctx, payload)
 	if err == nil && result.StatusCode() >= 400 {
-		err = fmt.Errorf(string(result.Body))
+		err = clientError(result.StatusCode(), result.Body)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create managed resource, got error: %s", err))
 		return
 	}
 }
+
+// annotatedResourceTypes are the types of object whose annotations record whether they
+// were created by Terraform.
+var annotatedResourceTypes = []string{"catalog_type", "schedule", "workflow"}
+
+// annotatedResource is an object along with its annotations, which record the version of
+// Terraform that last managed it, if any.
+type annotatedResource struct {
+	ResourceType string
+	ID           string
+	Name         string
+	Annotations  map[string]string
+}
+
+// listAnnotatedResources loads every object of the given types along with its
+// annotations. Workflows only return annotations when loaded individually, so this
+// makes a request per workflow, other than those for which skipWorkflow returns true.
+func listAnnotatedResources(ctx context.Context, providerData *IncidentProviderData, resourceTypes []string, skipWorkflow func(id string) bool) ([]annotatedResource, error) {
+	resources := []annotatedResource{}
+
+	if lo.Contains(resourceTypes, "catalog_type") {
+		catalogTypes, err := providerData.CatalogTypes.list(ctx, providerData.ClientFor(apiKeyFamilyCatalog))
+		if err != nil {
+			return nil, errors.Wrap(err, "listing catalog types")
+		}
+		for _, catalogType := range catalogTypes {
+			resources = append(resources, annotatedResource{"catalog_type", catalogType.Id, catalogType.Name, catalogType.Annotations})
+		}
+	}
+
+	if lo.Contains(resourceTypes, "schedule") {
+		schedules, err := listSchedules(ctx, providerData.ClientFor(apiKeyFamilyOnCall))
+		if err != nil {
+			return nil, errors.Wrap(err, "listing schedules")
+		}
+		for _, schedule := range schedules {
+			resources = append(resources, annotatedResource{"schedule", schedule.Id, schedule.Name, schedule.Annotations})
+		}
+	}
+
+	if lo.Contains(resourceTypes, "workflow") {
+		workflowsClient := providerData.ClientFor(apiKeyFamilyWorkflows)
+		workflows, err := workflowsClient.WorkflowsV2ListWorkflowsWithResponse(ctx)
+		if err == nil && workflows.StatusCode() >= 400 {
+			err = clientError(workflows.StatusCode(), workflows.Body)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "listing workflows")
+		}
+		for _, workflow := range workflows.JSON200.Workflows {
+			if skipWorkflow != nil && skipWorkflow(workflow.Id) {
+				continue
+			}
+
+			result, err := workflowsClient.WorkflowsV2ShowWorkflowWithResponse(ctx, workflow.Id)
+			if err == nil && result.StatusCode() >= 400 {
+				err = clientError(result.StatusCode(), result.Body)
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, "reading workflow")
+			}
+
+			resources = append(resources, annotatedResource{"workflow", workflow.Id, workflow.Name, result.JSON200.ManagementMeta.Annotations})
+		}
+	}
+
+	return resources, nil
+}
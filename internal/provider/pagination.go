@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// maxPageSize is the largest page size accepted by the API's list endpoints.
+const maxPageSize = 250
+
+// paginationAttributes are shared by all list data sources, so large organisations
+// can fetch a sample of results rather than everything, keeping plans fast and state
+// small. Data source models should include matching Limit, After and TotalCount
+// fields.
+func paginationAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	return lo.Assign(attributes, map[string]schema.Attribute{
+		"limit": schema.Int64Attribute{
+			MarkdownDescription: "The maximum number of results to return. If not set, all results are returned.",
+			Optional:            true,
+		},
+		"after": schema.StringAttribute{
+			MarkdownDescription: "Only return results after the one with this ID, as used to page through results.",
+			Optional:            true,
+		},
+		"total_count": schema.Int64Attribute{
+			MarkdownDescription: "The total number of results available, ignoring `limit` and `after`, if known.",
+			Computed:            true,
+		},
+	})
+}
+
+// pageFetcher loads a single page of results from a paginated API endpoint,
+// returning the cursor for the next page or nil if there are no more results.
+type pageFetcher[T any] func(ctx context.Context, pageSize int64, after *string) (page []T, next *string, err error)
+
+// paginate loads results from a paginated API endpoint, starting after the given
+// cursor, until there are no more results or we've reached the limit.
+func paginate[T any](ctx context.Context, limit types.Int64, after types.String, fetch pageFetcher[T]) ([]T, error) {
+	var (
+		results = []T{}
+		cursor  *string
+	)
+	if !after.IsNull() && !after.IsUnknown() {
+		cursor = lo.ToPtr(after.ValueString())
+	}
+
+	for {
+		pageSize := int64(maxPageSize)
+		if !limit.IsNull() && !limit.IsUnknown() {
+			pageSize = lo.Min([]int64{pageSize, limit.ValueInt64() - int64(len(results))})
+		}
+		if pageSize <= 0 {
+			return results, nil
+		}
+
+		page, next, err := fetch(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, page...)
+		if len(page) == 0 || next == nil {
+			return results, nil // end pagination
+		}
+
+		cursor = next
+	}
+}
+
+// paginateSlice applies the same limit and after controls as paginate to endpoints
+// that return all results in one response.
+func paginateSlice[T any](items []T, id func(T) string, limit types.Int64, after types.String) []T {
+	if !after.IsNull() && !after.IsUnknown() {
+		_, idx, found := lo.FindIndexOf(items, func(item T) bool {
+			return id(item) == after.ValueString()
+		})
+		if found {
+			items = items[idx+1:]
+		} else {
+			items = []T{}
+		}
+	}
+
+	if !limit.IsNull() && !limit.IsUnknown() && int64(len(items)) > limit.ValueInt64() {
+		items = items[:lo.Max([]int64{limit.ValueInt64(), 0})]
+	}
+
+	return items
+}
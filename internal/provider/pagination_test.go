@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+func TestPaginate(t *testing.T) {
+	items := lo.Map(lo.Range(600), func(idx int, _ int) string {
+		return strconv.Itoa(idx)
+	})
+
+	// Behaves like our list endpoints, where the cursor is the ID of the last item.
+	fetch := func(ctx context.Context, pageSize int64, after *string) ([]string, *string, error) {
+		remaining := items
+		if after != nil {
+			idx, _ := strconv.Atoi(*after)
+			remaining = items[idx+1:]
+		}
+
+		page := remaining[:lo.Min([]int{int(pageSize), len(remaining)})]
+		if len(page) == 0 {
+			return page, nil, nil
+		}
+
+		return page, lo.ToPtr(page[len(page)-1]), nil
+	}
+
+	for _, tc := range []struct {
+		name  string
+		limit types.Int64
+		after types.String
+		want  []string
+	}{
+		{"all results", types.Int64Null(), types.StringNull(), items},
+		{"limit", types.Int64Value(300), types.StringNull(), items[:300]},
+		{"after", types.Int64Null(), types.StringValue("549"), items[550:]},
+		{"limit and after", types.Int64Value(2), types.StringValue("10"), []string{"11", "12"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := paginate(context.Background(), tc.limit, tc.after, fetch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %d results, want %d", len(got), len(tc.want))
+			}
+		})
+	}
+}
+
+func TestPaginateSlice(t *testing.T) {
+	items := []string{"a", "b", "c", "d"}
+	id := func(item string) string { return item }
+
+	for _, tc := range []struct {
+		name  string
+		limit types.Int64
+		after types.String
+		want  []string
+	}{
+		{"all results", types.Int64Null(), types.StringNull(), items},
+		{"limit", types.Int64Value(2), types.StringNull(), []string{"a", "b"}},
+		{"after", types.Int64Null(), types.StringValue("b"), []string{"c", "d"}},
+		{"unknown after", types.Int64Null(), types.StringValue("z"), []string{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := paginateSlice(items, id, tc.limit, tc.after); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -5,18 +5,22 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	_ "embed"
 
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
 	"github.com/motemen/go-loghttp"
+	"github.com/samber/lo"
 )
 
 var _ provider.Provider = &IncidentProvider{}
@@ -26,13 +30,49 @@ type IncidentProvider struct {
 }
 
 type IncidentProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	APIKey                types.String `tfsdk:"api_key"`
+	APIKeys               types.Map    `tfsdk:"api_keys"`
+	CatalogTypeNamePrefix types.String `tfsdk:"catalog_type_name_prefix"`
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	ApplyTimeBudget       types.String `tfsdk:"apply_time_budget"`
 }
 
 type IncidentProviderData struct {
-	Client           *client.ClientWithResponses
-	TerraformVersion string
+	Client                *client.ClientWithResponses
+	FamilyClients         map[string]*client.ClientWithResponses
+	TerraformVersion      string
+	DashboardURL          *DashboardURL
+	CatalogTypeNamePrefix string
+	CatalogTypes          *catalogTypesCache
+}
+
+// Resources and data sources are grouped into families that can each be given their
+// own API key through api_keys, so each can use a key with only the scopes it needs.
+const (
+	apiKeyFamilyCatalog      = "catalog"
+	apiKeyFamilyCustomFields = "custom_fields"
+	apiKeyFamilyOnCall       = "on_call"
+	apiKeyFamilySettings     = "settings"
+	apiKeyFamilyWorkflows    = "workflows"
+)
+
+var apiKeyFamilies = []string{
+	apiKeyFamilyCatalog,
+	apiKeyFamilyCustomFields,
+	apiKeyFamilyOnCall,
+	apiKeyFamilySettings,
+	apiKeyFamilyWorkflows,
+}
+
+// ClientFor returns the client to use for a family of resources, which uses the
+// family's key from api_keys if there is one, or the default api_key otherwise.
+func (d *IncidentProviderData) ClientFor(family string) *client.ClientWithResponses {
+	if client, ok := d.FamilyClients[family]; ok {
+		return client
+	}
+
+	return d.Client
 }
 
 func New(version string) func() provider.Provider {
@@ -67,10 +107,30 @@ Registry](https://registry.terraform.io/providers/incident-io/incident/latest).
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key for incident.io (https://app.incident.io/settings/api-keys). Sourced from the `INCIDENT_API_KEY` environment variable, if set.",
+				MarkdownDescription: "API key for incident.io (https://app.incident.io/settings/api-keys). Sourced from the `INCIDENT_API_KEY` environment variable, if set. Read-only keys can be used with data sources, but managing resources requires a key that can edit configuration.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_keys": schema.MapAttribute{
+				MarkdownDescription: fmt.Sprintf("API keys to use for specific families of resources and data sources, instead of `api_key`. This allows using keys with only the scopes each family needs. Keys of the map must be one of: %s. Each key must be known when configuring the provider, so can't come from a resource created in the same apply.", strings.Join(lo.Map(apiKeyFamilies, func(family string, _ int) string {
+					return fmt.Sprintf("`%s`", family)
+				}), ", ")),
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"catalog_type_name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Pattern used to derive the `type_name` of catalog types that don't set one, where `*` is replaced by the catalog type's name with spaces and punctuation removed. For example, `Custom[\"Acme*\"]` gives a type named `Service tier` the type name `Custom[\"AcmeServiceTier\"]`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for each API request before giving up, as a duration such as `30s`. If not set, requests can take as long as the API does.",
+				Optional:            true,
+			},
+			"apply_time_budget": schema.StringAttribute{
+				MarkdownDescription: "How long a plan or apply can spend making API requests, as a duration such as `20m`. Once it's spent, no new requests are started: requests in flight finish, but resources that make several requests may fail partway through, and the state of those that finished is saved. Set this below your CI job's timeout so Terraform stops cleanly rather than being killed partway through a write.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -99,14 +159,112 @@ func (p *IncidentProvider) Configure(ctx context.Context, req provider.Configure
 		apiKey = data.APIKey.ValueString()
 	}
 
+	catalogTypeNamePrefix := data.CatalogTypeNamePrefix.ValueString()
+	if catalogTypeNamePrefix != "" && strings.Count(catalogTypeNamePrefix, "*") != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("catalog_type_name_prefix"),
+			"Invalid catalog type name prefix",
+			fmt.Sprintf("Expected exactly one * to be replaced by the catalog type name, got: %s", catalogTypeNamePrefix),
+		)
+		return
+	}
+
+	requestTimeout, err := parseDurationAttribute(data.RequestTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request timeout", err.Error())
+		return
+	}
+	applyTimeBudget, err := parseDurationAttribute(data.ApplyTimeBudget)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("apply_time_budget"), "Invalid apply time budget", err.Error())
+		return
+	}
+
+	familyAPIKeys := map[string]types.String{}
+	if !data.APIKeys.IsNull() && !data.APIKeys.IsUnknown() {
+		resp.Diagnostics.Append(data.APIKeys.ElementsAs(ctx, &familyAPIKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	transport := newTransport()
+	if applyTimeBudget > 0 {
+		transport = &budgetTransport{
+			Transport: transport,
+			Budget:    applyTimeBudget,
+			Deadline:  time.Now().Add(applyTimeBudget),
+		}
+	}
+
+	familyClients := map[string]*client.ClientWithResponses{}
+	for family, familyAPIKey := range familyAPIKeys {
+		if !lo.Contains(apiKeyFamilies, family) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_keys").AtMapKey(family),
+				"Invalid API key family",
+				fmt.Sprintf("Expected one of %s, got: %s", strings.Join(apiKeyFamilies, ", "), family),
+			)
+			return
+		}
+
+		// Falling back to the default key would make requests with the wrong scopes, or
+		// with no key at all, so a family's key must be set whenever it's listed.
+		if familyAPIKey.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_keys").AtMapKey(family),
+				"Unknown API key",
+				fmt.Sprintf("The API key for %s must be known when configuring the provider, so can't come from a resource that hasn't been created yet.", family),
+			)
+			return
+		}
+		if familyAPIKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_keys").AtMapKey(family),
+				"Missing API key",
+				fmt.Sprintf("The API key for %s is null. Remove it from api_keys to use the default api_key instead.", family),
+			)
+			return
+		}
+
+		familyClients[family] = p.buildClient(endpoint, familyAPIKey.ValueString(), transport, requestTimeout)
+	}
+
+	client := p.buildClient(endpoint, apiKey, transport, requestTimeout)
+	dashboardURL := NewDashboardURL(client)
+
+	// Share the cache between resources and data sources, so writes from one are
+	// seen by lookups from the other.
+	catalogTypes := &catalogTypesCache{}
+
+	resp.DataSourceData = &IncidentProviderData{
+		Client:                client,
+		FamilyClients:         familyClients,
+		TerraformVersion:      req.TerraformVersion,
+		DashboardURL:          dashboardURL,
+		CatalogTypeNamePrefix: catalogTypeNamePrefix,
+		CatalogTypes:          catalogTypes,
+	}
+	resp.ResourceData = &IncidentProviderData{
+		Client:                client,
+		FamilyClients:         familyClients,
+		TerraformVersion:      req.TerraformVersion,
+		DashboardURL:          dashboardURL,
+		CatalogTypeNamePrefix: catalogTypeNamePrefix,
+		CatalogTypes:          catalogTypes,
+	}
+}
+
+func (p *IncidentProvider) buildClient(endpoint, apiKey string, transport http.RoundTripper, requestTimeout time.Duration) *client.ClientWithResponses {
 	bearerTokenProvider, bearerTokenProviderErr := securityprovider.NewSecurityProviderBearerToken(apiKey)
 	if bearerTokenProviderErr != nil {
 		panic(bearerTokenProviderErr)
 	}
 
 	base := cleanhttp.DefaultClient()
+	base.Timeout = requestTimeout
 	base.Transport = &loghttp.Transport{
-		Transport: cleanhttp.DefaultTransport(),
+		Transport: transport,
 	}
 
 	client, err := client.NewClientWithResponses(
@@ -123,38 +281,77 @@ func (p *IncidentProvider) Configure(ctx context.Context, req provider.Configure
 		panic(err)
 	}
 
-	resp.DataSourceData = &IncidentProviderData{
-		Client:           client,
-		TerraformVersion: req.TerraformVersion,
+	return client
+}
+
+// parseDurationAttribute parses a duration such as 30s from the provider config,
+// returning zero if it isn't set.
+func parseDurationAttribute(value types.String) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return 0, nil
 	}
-	resp.ResourceData = &IncidentProviderData{
-		Client:           client,
-		TerraformVersion: req.TerraformVersion,
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, fmt.Errorf("Expected a duration such as 30s, got: %s", value.ValueString())
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("Expected a positive duration, got: %s", value.ValueString())
 	}
+
+	return duration, nil
 }
 
 func (p *IncidentProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIncidentCatalogEntriesResource,
+		NewIncidentCatalogEntryAttributeValueResource,
 		NewIncidentCatalogEntryResource,
 		NewIncidentCatalogTypeAttributesResource,
 		NewIncidentCatalogTypeResource,
 		NewIncidentCustomFieldOptionResource,
+		NewIncidentCustomFieldOptionsResource,
 		NewIncidentCustomFieldResource,
 		NewIncidentEscalationPathResource,
+		NewIncidentIncidentResource,
 		NewIncidentRoleResource,
 		NewIncidentSeverityResource,
 		NewIncidentStatusResource,
 		NewIncidentScheduleResource,
+		NewIncidentScheduleRotationResource,
 		NewIncidentWorkflowResource,
 	}
 }
 
 func (p *IncidentProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewIncidentCatalogEntriesDataSource,
+		NewIncidentCatalogEntryDataSource,
 		NewIncidentCatalogTypeDataSource,
+		NewIncidentCatalogTypeSchemaDataSource,
+		NewIncidentCatalogTypesDataSource,
+		NewIncidentConfigSnapshotDataSource,
+		NewIncidentConfigSnapshotDiffDataSource,
 		NewIncidentCustomFieldDataSource,
 		NewIncidentCustomFieldOptionDataSource,
+		NewIncidentCustomFieldsDataSource,
+		NewIncidentFollowUpsDataSource,
+		NewIncidentIdentityDataSource,
+		NewIncidentIncidentDataSource,
+		NewIncidentIncidentRolesDataSource,
+		NewIncidentIncidentsDataSource,
+		NewIncidentIncidentTimestampsDataSource,
+		NewIncidentIncidentTypeDataSource,
+		NewIncidentManagedResourcesDataSource,
+		NewIncidentOnCallDataSource,
+		NewIncidentOrphanedResourcesDataSource,
+		NewIncidentScheduleDataSource,
+		NewIncidentScheduleOverridesDataSource,
+		NewIncidentSchedulesDataSource,
+		NewIncidentSettingsLookupDataSource,
+		NewIncidentSeveritiesDataSource,
+		NewIncidentStatusDataSource,
+		NewIncidentStatusesDataSource,
 		NewIncidentUserDataSource,
 	}
 }
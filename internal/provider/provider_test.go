@@ -1,17 +1,85 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/incident-io/terraform-provider-incident/internal/apimock"
 )
 
 var testRunID = uuid.NewString()
 
+// TestMain runs acceptance tests against an in-memory mock of the API when
+// INCIDENT_APIMOCK is set, rather than the organisation INCIDENT_API_KEY belongs to.
+// The mock only implements some endpoints, so use it with -run to pick tests that
+// only use those, as make testacc-apimock does.
+func TestMain(m *testing.M) {
+	if os.Getenv("INCIDENT_APIMOCK") == "" {
+		os.Exit(m.Run())
+	}
+
+	server := httptest.NewServer(apimock.New())
+	os.Setenv("INCIDENT_ENDPOINT", server.URL)
+	os.Setenv("INCIDENT_API_KEY", "apimock")
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+func TestIncidentProviderConfigureAPIKeys(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	configType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	apiKeysType := configType.AttributeTypes["api_keys"]
+
+	for _, tc := range []struct {
+		name          string
+		apiKey        tftypes.Value
+		expectedError string
+	}{
+		{"known", tftypes.NewValue(tftypes.String, "catalog-key"), ""},
+		{"unknown", tftypes.NewValue(tftypes.String, tftypes.UnknownValue), "Unknown API key"},
+		{"null", tftypes.NewValue(tftypes.String, nil), "Missing API key"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			attributes := map[string]tftypes.Value{}
+			for name, attributeType := range configType.AttributeTypes {
+				attributes[name] = tftypes.NewValue(attributeType, nil)
+			}
+			attributes["api_key"] = tftypes.NewValue(tftypes.String, "default-key")
+			attributes["api_keys"] = tftypes.NewValue(apiKeysType, map[string]tftypes.Value{"catalog": tc.apiKey})
+
+			var resp provider.ConfigureResponse
+			p.Configure(ctx, provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, attributes)},
+			}, &resp)
+
+			if tc.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Summary() != tc.expectedError {
+				t.Fatalf("expected %q, got %v", tc.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
 func StableSuffix(thing string) string {
 	return fmt.Sprintf("%s (%s)", thing, testRunID)
 }
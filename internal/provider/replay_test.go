@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// Conformance tests replay API interactions recorded in testdata/fixtures through each
+// resource's CRUD methods, so that changes to the generated client or API schema that
+// would break a resource fail in `go test` without needing live credentials.
+//
+// To re-record a fixture against a real account, run the test with
+// INCIDENT_RECORD_FIXTURES=1 and INCIDENT_API_KEY set. Check the diff before
+// committing: fixtures should only ever contain test data.
+
+// cassette is the list of interactions recorded for a single test, in order.
+type cassette struct {
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Method       string          `json:"method"`
+	Path         string          `json:"path"`
+	RequestBody  json.RawMessage `json:"request_body,omitempty"`
+	Status       int             `json:"status"`
+	ResponseBody json.RawMessage `json:"response_body,omitempty"`
+}
+
+// cassetteDoer serves requests from a cassette, failing the test if a request doesn't
+// match the next recorded interaction. When recording, it forwards requests to the
+// live API instead and saves what happened.
+type cassetteDoer struct {
+	t        *testing.T
+	filename string
+	cassette cassette
+	next     int
+	live     client.HttpRequestDoer
+}
+
+func (d *cassetteDoer) Do(req *http.Request) (*http.Response, error) {
+	d.t.Helper()
+
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		if requestBody, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	if d.live != nil {
+		return d.record(req, requestBody)
+	}
+
+	if d.next >= len(d.cassette.Interactions) {
+		d.t.Fatalf("unexpected request %s %s, no more interactions in %s", req.Method, req.URL.Path, d.filename)
+	}
+	recorded := d.cassette.Interactions[d.next]
+	d.next++
+
+	if req.Method != recorded.Method || req.URL.Path != recorded.Path {
+		d.t.Fatalf("expected request %s %s, got %s %s", recorded.Method, recorded.Path, req.Method, req.URL.Path)
+	}
+	if len(recorded.RequestBody) > 0 && !jsonEqual(d.t, recorded.RequestBody, requestBody) {
+		d.t.Errorf("request body for %s %s doesn't match fixture\nexpected: %s\ngot: %s", req.Method, req.URL.Path, recorded.RequestBody, requestBody)
+	}
+
+	header := http.Header{}
+	if len(recorded.ResponseBody) > 0 {
+		header.Set("Content-Type", "application/json")
+	}
+
+	return &http.Response{
+		StatusCode: recorded.Status,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(recorded.ResponseBody)),
+		Request:    req,
+	}, nil
+}
+
+func (d *cassetteDoer) record(req *http.Request, requestBody []byte) (*http.Response, error) {
+	resp, err := d.live.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	d.cassette.Interactions = append(d.cassette.Interactions, interaction{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		RequestBody:  compactJSON(requestBody),
+		Status:       resp.StatusCode,
+		ResponseBody: compactJSON(responseBody),
+	})
+
+	return resp, nil
+}
+
+// finish saves the cassette when recording, or checks every interaction was replayed.
+func (d *cassetteDoer) finish() {
+	d.t.Helper()
+
+	if d.live != nil {
+		payload, err := json.MarshalIndent(d.cassette, "", "  ")
+		if err != nil {
+			d.t.Fatal(err)
+		}
+		if err := os.WriteFile(d.filename, append(payload, '\n'), 0o644); err != nil {
+			d.t.Fatal(err)
+		}
+
+		return
+	}
+
+	if remaining := len(d.cassette.Interactions) - d.next; remaining > 0 {
+		d.t.Errorf("%d recorded interactions in %s were never requested", remaining, d.filename)
+	}
+}
+
+// newReplayClient builds an API client that replays the named fixture, or records it
+// if INCIDENT_RECORD_FIXTURES is set.
+func newReplayClient(t *testing.T, name string) (*client.ClientWithResponses, *cassetteDoer) {
+	t.Helper()
+
+	doer := &cassetteDoer{
+		t:        t,
+		filename: filepath.Join("testdata", "fixtures", name+".json"),
+	}
+	endpoint := "https://api.incident.io"
+	options := []client.ClientOption{client.WithHTTPClient(doer)}
+
+	if os.Getenv("INCIDENT_RECORD_FIXTURES") != "" {
+		bearerTokenProvider, err := securityprovider.NewSecurityProviderBearerToken(os.Getenv("INCIDENT_API_KEY"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if override := os.Getenv("INCIDENT_ENDPOINT"); override != "" {
+			endpoint = override
+		}
+
+		doer.live = http.DefaultClient
+		options = append(options, client.WithRequestEditorFn(bearerTokenProvider.Intercept))
+	} else {
+		payload, err := os.ReadFile(doer.filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(payload, &doer.cassette); err != nil {
+			t.Fatalf("parsing %s: %s", doer.filename, err)
+		}
+	}
+
+	apiClient, err := client.NewClientWithResponses(endpoint, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return apiClient, doer
+}
+
+// replayResourceCRUD runs a resource through create, read, update and delete against a
+// recorded fixture, returning the state after each of create, read and update. The
+// create and update models should be pointers to the resource's model, with unknown
+// values for anything computed. The ID from create is carried into the update plan.
+func replayResourceCRUD(t *testing.T, res resource.Resource, fixture string, create, update any) (created, read, updated tfsdk.State) {
+	t.Helper()
+
+	ctx := context.Background()
+	apiClient, doer := newReplayClient(t, fixture)
+	defer doer.finish()
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	schema := schemaResp.Schema
+	empty := tftypes.NewValue(schema.Type().TerraformType(ctx), nil)
+
+	if configurable, ok := res.(resource.ResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{
+			ProviderData: &IncidentProviderData{Client: apiClient},
+		}, configureResp)
+		requireNoErrors(t, "configure", configureResp.Diagnostics)
+	}
+
+	plan := tfsdk.Plan{Schema: schema, Raw: empty}
+	requireNoErrors(t, "building create plan", plan.Set(ctx, create))
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schema, Raw: empty}}
+	res.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: plan.Raw},
+		Plan:   plan,
+	}, createResp)
+	requireNoErrors(t, "create", createResp.Diagnostics)
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	res.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	requireNoErrors(t, "read", readResp.Diagnostics)
+
+	var id types.String
+	requireNoErrors(t, "reading id", readResp.State.GetAttribute(ctx, path.Root("id"), &id))
+
+	plan = tfsdk.Plan{Schema: schema, Raw: empty}
+	requireNoErrors(t, "building update plan", plan.Set(ctx, update))
+	requireNoErrors(t, "setting update id", plan.SetAttribute(ctx, path.Root("id"), id))
+
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	res.Update(ctx, resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: plan.Raw},
+		Plan:   plan,
+		State:  readResp.State,
+	}, updateResp)
+	requireNoErrors(t, "update", updateResp.Diagnostics)
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	res.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	requireNoErrors(t, "delete", deleteResp.Diagnostics)
+
+	return createResp.State, readResp.State, updateResp.State
+}
+
+// requireStateAttribute checks a string attribute in the state.
+func requireStateAttribute(t *testing.T, state tfsdk.State, name, want string) {
+	t.Helper()
+
+	var got types.String
+	requireNoErrors(t, "reading "+name, state.GetAttribute(context.Background(), path.Root(name), &got))
+	if got.ValueString() != want {
+		t.Errorf("expected %s to be %q, got %q", name, want, got.ValueString())
+	}
+}
+
+func requireNoErrors(t *testing.T, step string, diags interface{ HasError() bool }) {
+	t.Helper()
+
+	if diags.HasError() {
+		t.Fatalf("%s: %v", step, diags)
+	}
+}
+
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+
+	var aValue, bValue any
+	if err := json.Unmarshal(a, &aValue); err != nil {
+		t.Fatalf("invalid JSON %s: %s", a, err)
+	}
+	if err := json.Unmarshal(b, &bValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
+
+func compactJSON(payload []byte) json.RawMessage {
+	if len(payload) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, payload); err != nil {
+		return nil
+	}
+
+	return buf.Bytes()
+}
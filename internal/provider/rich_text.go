@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Rich text fields accept markdown, which the API stores in its own format and
+// renders back to markdown when read. That round-trip doesn't always give back
+// exactly what was sent: trailing newlines from heredocs are dropped, list markers
+// are rewritten, links are turned into autolinks, and so on.
+//
+// The version of the plugin framework we use doesn't support semantic equality on
+// custom types, so instead we compare a normalised form of the markdown whenever we
+// read values back from the API, keeping whichever value Terraform already has if
+// the two are equivalent. As state then matches config, no diff is planned.
+
+var (
+	richTextListMarker     = regexp.MustCompile(`(?m)^(\s*)[*+] `)
+	richTextBlankLines     = regexp.MustCompile(`\n{3,}`)
+	richTextTrailingSpaces = regexp.MustCompile(`(?m)[ \t]+$`)
+	richTextAutolink       = regexp.MustCompile(`<(https?://[^\s>]+)>`)
+	richTextLink           = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+)
+
+// normaliseRichText reduces markdown to a canonical form, such that two strings that
+// render the same in incident.io normalise to the same value.
+func normaliseRichText(value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	value = richTextTrailingSpaces.ReplaceAllString(value, "")
+	value = richTextListMarker.ReplaceAllString(value, "$1- ")
+	value = richTextBlankLines.ReplaceAllString(value, "\n\n")
+	value = richTextAutolink.ReplaceAllString(value, "$1")
+	value = richTextLink.ReplaceAllStringFunc(value, func(link string) string {
+		// Links whose text is their URL render the same as the bare URL.
+		parts := richTextLink.FindStringSubmatch(link)
+		if parts[1] == parts[2] {
+			return parts[2]
+		}
+
+		return link
+	})
+
+	return strings.TrimSpace(value)
+}
+
+// richTextEquivalent returns true if both values render the same rich text.
+func richTextEquivalent(a, b string) bool {
+	return normaliseRichText(a) == normaliseRichText(b)
+}
+
+// preserveRichText returns the value we already had for a rich text field if it's
+// equivalent to what the API gave back, or the API value otherwise. This should be
+// used whenever building a model from an API response, passing the prior plan or
+// state value.
+func preserveRichText(previous types.String, value string) types.String {
+	if !previous.IsNull() && !previous.IsUnknown() && richTextEquivalent(previous.ValueString(), value) {
+		return previous
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRichTextEquivalent(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"trailing newline", "Page the on-call.\n", "Page the on-call.", true},
+		{"windows line endings", "Page the on-call.\r\nThen update the status page.", "Page the on-call.\nThen update the status page.", true},
+		{"trailing spaces", "Page the on-call.  \nThen update the status page.", "Page the on-call.\nThen update the status page.", true},
+		{"list markers", "* one\n* two", "- one\n- two", true},
+		{"nested list markers", "- one\n  + two", "- one\n  - two", true},
+		{"blank lines", "One\n\n\n\nTwo", "One\n\nTwo", true},
+		{"autolink", "See https://example.com", "See <https://example.com>", true},
+		{"link to itself", "See [https://example.com](https://example.com)", "See https://example.com", true},
+		{"link with text", "See [the runbook](https://example.com)", "See https://example.com", false},
+		{"different text", "Customers affected", "No customers affected", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := richTextEquivalent(tc.a, tc.b); got != tc.want {
+				t.Errorf("richTextEquivalent(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPreserveRichText(t *testing.T) {
+	previous := types.StringValue("* Page the on-call.\n")
+
+	if got := preserveRichText(previous, "- Page the on-call."); !got.Equal(previous) {
+		t.Errorf("expected equivalent value to be preserved, got %s", got)
+	}
+	if got := preserveRichText(previous, "- Page someone else."); got.ValueString() != "- Page someone else." {
+		t.Errorf("expected changed value from the API, got %s", got)
+	}
+	linked := types.StringValue("See [https://example.com](https://example.com)\n")
+	if got := preserveRichText(linked, "See <https://example.com>"); !got.Equal(linked) {
+		t.Errorf("expected equivalent link to be preserved, got %s", got)
+	}
+	if got := preserveRichText(types.StringNull(), "Imported"); got.ValueString() != "Imported" {
+		t.Errorf("expected value from the API without a previous value, got %s", got)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxIdleConnsPerHost matches the most requests we make to the API concurrently, which
+// is when incident_catalog_entries creates or updates entries in parallel.
+const maxIdleConnsPerHost = 10
+
+// newTransport builds the transport shared by every API client, so requests made with
+// different API keys still reuse connections. Unlike cleanhttp.DefaultTransport, this
+// keeps connections alive between requests, so we don't pay for a TLS handshake on
+// each one, which adds up quickly for clients far from the API.
+func newTransport() http.RoundTripper {
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.ForceAttemptHTTP2 = true
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	transport.IdleConnTimeout = 90 * time.Second
+
+	return &connectionStatsTransport{Transport: transport}
+}
+
+// connectionStatsTransport logs whether each request reused a connection, with
+// running totals, so TF_LOG=DEBUG shows whether connections are being kept alive.
+type connectionStatsTransport struct {
+	Transport http.RoundTripper
+
+	reused, opened atomic.Int64
+}
+
+func (t *connectionStatsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				t.reused.Add(1)
+			} else {
+				t.opened.Add(1)
+			}
+
+			tflog.Debug(ctx, "Got HTTP connection", map[string]interface{}{
+				"host":               req.URL.Host,
+				"reused":             info.Reused,
+				"idle_time":          info.IdleTime.String(),
+				"connections_reused": t.reused.Load(),
+				"connections_opened": t.opened.Load(),
+			})
+		},
+	}
+
+	return t.Transport.RoundTrip(req.WithContext(httptrace.WithClientTrace(ctx, trace)))
+}
+
+// budgetTransport refuses to start requests once the provider's apply_time_budget is
+// spent. Requests already in flight are left to finish, so no single write is cut off,
+// but an operation that makes several requests, such as creating a catalog type and
+// then its schema, can still fail between them. Terraform saves state for everything
+// that completed, including any partial state the failed operation recorded.
+type budgetTransport struct {
+	Transport http.RoundTripper
+	Budget    time.Duration
+	Deadline  time.Time
+}
+
+func (t *budgetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if time.Now().After(t.Deadline) {
+		return nil, fmt.Errorf("apply_time_budget of %s exceeded, not starting any more requests", t.Budget)
+	}
+
+	return t.Transport.RoundTrip(req)
+}
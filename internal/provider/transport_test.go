@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransportReusesConnections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	transport := newTransport().(*connectionStatsTransport)
+	httpClient := &http.Client{Transport: transport}
+
+	for idx := 0; idx < 3; idx++ {
+		resp, err := httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	if opened, reused := transport.opened.Load(), transport.reused.Load(); opened != 1 || reused != 2 {
+		t.Errorf("expected 1 connection opened and reused twice, got %d opened and %d reused", opened, reused)
+	}
+}
+
+func TestBudgetTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	transport := &budgetTransport{
+		Transport: http.DefaultTransport,
+		Budget:    time.Minute,
+		Deadline:  time.Now().Add(time.Minute),
+	}
+	httpClient := &http.Client{Transport: transport}
+
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected request within budget to succeed, got: %s", err)
+	}
+	resp.Body.Close()
+
+	transport.Deadline = time.Now().Add(-time.Second)
+	if _, err := httpClient.Get(server.URL); err == nil || !strings.Contains(err.Error(), "apply_time_budget of 1m0s exceeded") {
+		t.Errorf("expected request after budget to fail, got: %v", err)
+	}
+}
+
+func TestParseDurationAttribute(t *testing.T) {
+	for _, tc := range []struct {
+		value       types.String
+		expected    time.Duration
+		expectedErr bool
+	}{
+		{types.StringNull(), 0, false},
+		{types.StringValue("30s"), 30 * time.Second, false},
+		{types.StringValue("20m"), 20 * time.Minute, false},
+		{types.StringValue("thirty seconds"), 0, true},
+		{types.StringValue("0s"), 0, true},
+	} {
+		duration, err := parseDurationAttribute(tc.value)
+		if (err != nil) != tc.expectedErr {
+			t.Errorf("%s: expected error to be %v, got: %v", tc.value, tc.expectedErr, err)
+		}
+		if duration != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.value, tc.expected, duration)
+		}
+	}
+}